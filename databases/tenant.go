@@ -0,0 +1,51 @@
+package databases
+
+import (
+	"github.com/budimanlai/go-pkg/tenant"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// TenantScope returns a GORM scope that restricts queries to the given tenant
+// by adding a "tenant_id = ?" condition.
+//
+// Models queried with this scope must have a tenant_id column. An empty tenantID
+// matches no tenant rows, so a missing tenant never widens a query.
+//
+// Parameters:
+//   - tenantID: The tenant identifier to filter by
+//
+// Returns:
+//   - func(*gorm.DB) *gorm.DB: Scope to be passed to db.Scopes()
+//
+// Example:
+//
+//	var orders []Order
+//	db.Scopes(databases.TenantScope("acme")).Find(&orders)
+//	// SELECT * FROM orders WHERE tenant_id = 'acme'
+func TenantScope(tenantID string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("tenant_id = ?", tenantID)
+	}
+}
+
+// FromContext returns a TenantScope for the tenant stored in the request context
+// (see tenant.ID), e.g. by response.TenantMiddleware.
+// If no tenant was set, the scope matches no tenant rows.
+//
+// Parameters:
+//   - c: *fiber.Ctx - The Fiber context
+//
+// Returns:
+//   - func(*gorm.DB) *gorm.DB: Scope to be passed to db.Scopes()
+//
+// Example:
+//
+//	app.Get("/orders", func(c *fiber.Ctx) error {
+//	    var orders []Order
+//	    db.Scopes(databases.FromContext(c)).Find(&orders)
+//	    return c.JSON(orders)
+//	})
+func FromContext(c *fiber.Ctx) func(*gorm.DB) *gorm.DB {
+	return TenantScope(tenant.ID(c))
+}
@@ -0,0 +1,88 @@
+package databases
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/budimanlai/go-pkg/tenant"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type tenantOrder struct {
+	ID       uint
+	TenantID string
+	Item     string
+}
+
+func setupTenantDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	if err := db.AutoMigrate(&tenantOrder{}); err != nil {
+		t.Fatalf("Failed to migrate: %v", err)
+	}
+
+	db.Create(&[]tenantOrder{
+		{TenantID: "acme", Item: "anvil"},
+		{TenantID: "acme", Item: "rocket"},
+		{TenantID: "globex", Item: "laser"},
+	})
+	return db
+}
+
+func TestTenantScope(t *testing.T) {
+	db := setupTenantDB(t)
+
+	var orders []tenantOrder
+	if err := db.Scopes(TenantScope("acme")).Find(&orders).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(orders) != 2 {
+		t.Fatalf("Expected 2 orders for acme, got %d", len(orders))
+	}
+	for _, o := range orders {
+		if o.TenantID != "acme" {
+			t.Errorf("Expected tenant 'acme', got '%s'", o.TenantID)
+		}
+	}
+
+	var count int64
+	db.Model(&tenantOrder{}).Scopes(TenantScope("")).Count(&count)
+	if count != 0 {
+		t.Errorf("Expected empty tenant to match no rows, got %d", count)
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	db := setupTenantDB(t)
+
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		tenant.SetID(c, c.Get("X-Tenant-ID"))
+		return c.Next()
+	})
+	app.Get("/orders", func(c *fiber.Ctx) error {
+		var orders []tenantOrder
+		db.Scopes(FromContext(c)).Find(&orders)
+		return c.JSON(orders)
+	})
+
+	req := httptest.NewRequest("GET", "/orders", nil)
+	req.Header.Set("X-Tenant-ID", "globex")
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var orders []tenantOrder
+	if err := json.NewDecoder(resp.Body).Decode(&orders); err != nil {
+		t.Fatal(err)
+	}
+	if len(orders) != 1 || orders[0].Item != "laser" {
+		t.Errorf("Expected only globex's order, got %+v", orders)
+	}
+}
@@ -0,0 +1,54 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseBool parses a boolean-ish string as found in query parameters, env vars and config files.
+// Matching is case-insensitive and ignores surrounding whitespace.
+//
+// Accepted values:
+//   - true:  "1", "t", "true", "y", "yes", "on"
+//   - false: "0", "f", "false", "n", "no", "off"
+//
+// Parameters:
+//   - s: String to parse
+//
+// Returns:
+//   - bool: Parsed value
+//   - error: Error if s is empty or not one of the accepted values (e.g., "maybe", "2")
+//
+// Example:
+//
+//	enabled, err := ParseBool("Yes")
+//	// Output: true, nil
+func ParseBool(s string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "1", "t", "true", "y", "yes", "on":
+		return true, nil
+	case "0", "f", "false", "n", "no", "off":
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid boolean %q", s)
+}
+
+// ParseBoolDefault parses s like ParseBool, returning def if s is empty or not an accepted value.
+//
+// Parameters:
+//   - s: String to parse
+//   - def: Default value used when s is empty or invalid
+//
+// Returns:
+//   - bool: Parsed value or def
+//
+// Example:
+//
+//	debug := ParseBoolDefault(os.Getenv("DEBUG"), false)
+func ParseBoolDefault(s string, def bool) bool {
+	b, err := ParseBool(s)
+	if err != nil {
+		return def
+	}
+	return b
+}
@@ -0,0 +1,42 @@
+package helpers
+
+import (
+	"github.com/budimanlai/go-pkg/internal/chain"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Chain composes handlers into a single middleware that runs them in order.
+// Each handler continues the chain by calling c.Next() as usual; a handler that returns
+// without calling c.Next() (e.g., after writing a 401 response) short-circuits the chain
+// and the rest of the route. Once the last handler calls c.Next(), the route continues
+// with the handlers registered after the chain, so middlewares that wrap c.Next()
+// (timing, logging, recovery) observe the whole downstream request.
+//
+// The handlers run on a nested Fiber app sharing the same request and response. It is
+// built from the config of the app serving the request and registered on the same path
+// as the parent route, so locals, headers, c.Params() and c.Route().Path are
+// available inside the chain. Errors returned inside the chain or downstream are returned
+// by the composed handler.
+//
+// Parameters:
+//   - handlers: Middlewares to run in order
+//
+// Returns:
+//   - fiber.Handler: The composed middleware
+//
+// Example:
+//
+//	common := helpers.Chain(
+//	    requestid.New(),
+//	    i18n.I18nMiddleware(i18nMgr),
+//	    logger.FiberMiddleware(),
+//	)
+//	app.Use(common)
+func Chain(handlers ...fiber.Handler) fiber.Handler {
+	ch := chain.New(handlers...)
+
+	return func(c *fiber.Ctx) error {
+		_, err := ch.Run(c, c.Next)
+		return err
+	}
+}
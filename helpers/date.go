@@ -1,6 +1,11 @@
 package helpers
 
-import "time"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
 
 // StringToDate converts a string to a date format (YYYY-MM-DD).
 //
@@ -23,3 +28,86 @@ func StringToDate(dateStr string) (time.Time, error) {
 	}
 	return parsedTime, nil
 }
+
+// ParseExtendedDuration parses a duration string like time.ParseDuration, with additional
+// support for days ("d", 24h) and weeks ("w", 7d). Units can be combined in any order.
+//
+// Parameters:
+//   - s: Duration string (e.g., "7d", "2w", "1w2d3h", "1.5d", "-3d12h")
+//
+// Returns:
+//   - time.Duration: Parsed duration
+//   - error: Error if the string is empty, a number is missing its unit, or a unit is unknown
+//
+// Example:
+//
+//	d, err := ParseExtendedDuration("1w2d3h")
+//	// Output: 219h0m0s, nil
+func ParseExtendedDuration(s string) (time.Duration, error) {
+	orig := s
+
+	negative := false
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		negative = s[0] == '-'
+		s = s[1:]
+	}
+
+	if s == "" {
+		return 0, fmt.Errorf("invalid duration %q", orig)
+	}
+	if s == "0" {
+		return 0, nil
+	}
+
+	var total time.Duration
+	for s != "" {
+		// Read the numeric part
+		i := 0
+		for i < len(s) && (s[i] == '.' || (s[i] >= '0' && s[i] <= '9')) {
+			i++
+		}
+		if i == 0 {
+			return 0, fmt.Errorf("invalid duration %q: expected number at %q", orig, s)
+		}
+		number := s[:i]
+		s = s[i:]
+
+		// Read the unit part
+		j := 0
+		for j < len(s) && s[j] != '.' && (s[j] < '0' || s[j] > '9') {
+			j++
+		}
+		if j == 0 {
+			return 0, fmt.Errorf("invalid duration %q: missing unit after %q", orig, number)
+		}
+		unit := s[:j]
+		s = s[j:]
+
+		switch unit {
+		case "d", "w":
+			value, err := strconv.ParseFloat(number, 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid duration %q: bad number %q", orig, number)
+			}
+			day := 24 * time.Hour
+			if unit == "w" {
+				day *= 7
+			}
+			total += time.Duration(value * float64(day))
+		default:
+			d, err := time.ParseDuration(number + unit)
+			if err != nil {
+				if strings.Contains(err.Error(), "unknown unit") {
+					return 0, fmt.Errorf("invalid duration %q: unknown unit %q", orig, unit)
+				}
+				return 0, fmt.Errorf("invalid duration %q: %w", orig, err)
+			}
+			total += d
+		}
+	}
+
+	if negative {
+		total = -total
+	}
+	return total, nil
+}
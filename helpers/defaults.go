@@ -0,0 +1,144 @@
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/budimanlai/go-pkg/types"
+)
+
+var (
+	durationType = reflect.TypeOf(time.Duration(0))
+	timeType     = reflect.TypeOf(time.Time{})
+	utcTimeType  = reflect.TypeOf(types.UTCTime{})
+)
+
+// SetDefaults fills zero-valued struct fields from their `default:"..."` tag.
+// Fields that already hold a non-zero value are left untouched, so it can run
+// right after body parsing and before validation.
+//
+// Supported field types: string, bool, signed/unsigned integers, floats,
+// time.Duration (e.g., "30s", "7d"), time.Time and types.UTCTime (RFC3339),
+// and pointers to these (a nil pointer is allocated). Nested structs without
+// a default tag are processed recursively.
+//
+// Parameters:
+//   - v: Pointer to the struct to fill
+//
+// Returns:
+//   - error: Error if v is not a struct pointer or a default cannot be parsed
+//
+// Example:
+//
+//	type ListRequest struct {
+//	    Limit  int    `json:"limit" default:"20" validate:"max=100"`
+//	    Sort   string `json:"sort" default:"created_at"`
+//	    Active bool   `json:"active" default:"true"`
+//	}
+//
+//	var req ListRequest
+//	c.BodyParser(&req)
+//	if err := SetDefaults(&req); err != nil {
+//	    return err
+//	}
+//	// req.Limit == 20 unless the client sent a value
+func SetDefaults(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("SetDefaults: expected a non-nil pointer to a struct")
+	}
+	return setStructDefaults(rv.Elem())
+}
+
+// setStructDefaults applies default tags to the fields of a struct value.
+func setStructDefaults(rv reflect.Value) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		value := rv.Field(i)
+		if !value.CanSet() {
+			continue
+		}
+
+		tag, hasDefault := field.Tag.Lookup("default")
+		if !hasDefault {
+			// Recurse into nested structs that are not themselves scalar values
+			if value.Kind() == reflect.Struct && value.Type() != timeType && value.Type() != utcTimeType {
+				if err := setStructDefaults(value); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if !value.IsZero() {
+			continue
+		}
+
+		// Allocate nil pointers so the default can be stored in the pointed-to value
+		if value.Kind() == reflect.Ptr {
+			value.Set(reflect.New(value.Type().Elem()))
+			value = value.Elem()
+		}
+
+		if err := setDefaultValue(value, tag); err != nil {
+			return fmt.Errorf("SetDefaults: field %s: %w", field.Name, err)
+		}
+	}
+	return nil
+}
+
+// setDefaultValue parses raw into the value according to its type.
+func setDefaultValue(value reflect.Value, raw string) error {
+	switch value.Type() {
+	case durationType:
+		d, err := ParseExtendedDuration(raw)
+		if err != nil {
+			return err
+		}
+		value.SetInt(int64(d))
+		return nil
+	case timeType, utcTimeType:
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return err
+		}
+		value.Set(reflect.ValueOf(t).Convert(value.Type()))
+		return nil
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(raw)
+	case reflect.Bool:
+		b, err := ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		value.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, value.Type().Bits())
+		if err != nil {
+			return err
+		}
+		value.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 10, value.Type().Bits())
+		if err != nil {
+			return err
+		}
+		value.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(raw, value.Type().Bits())
+		if err != nil {
+			return err
+		}
+		value.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type %s", value.Type())
+	}
+	return nil
+}
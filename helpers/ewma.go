@@ -0,0 +1,58 @@
+package helpers
+
+import "sync"
+
+// defaultEWMAAlpha is used when NewEWMA receives an alpha outside (0, 1]
+const defaultEWMAAlpha = 0.1
+
+// EWMA is a thread-safe exponentially weighted moving average.
+// Each sample updates the average as: value = alpha*sample + (1-alpha)*value.
+// Higher alpha values react faster to recent samples; lower values smooth more.
+type EWMA struct {
+	mu          sync.Mutex
+	alpha       float64
+	value       float64
+	initialized bool
+}
+
+// NewEWMA creates an exponentially weighted moving average with the given smoothing factor.
+// alpha must be in the range (0, 1]; other values fall back to 0.1.
+//
+// Parameters:
+//   - alpha: Weight of each new sample
+//
+// Returns:
+//   - *EWMA: Moving average with no samples
+//
+// Example:
+//
+//	latency := NewEWMA(0.2)
+//	latency.Add(float64(elapsed.Milliseconds()))
+//	timeout := time.Duration(latency.Value()*3) * time.Millisecond
+func NewEWMA(alpha float64) *EWMA {
+	if alpha <= 0 || alpha > 1 {
+		alpha = defaultEWMAAlpha
+	}
+	return &EWMA{alpha: alpha}
+}
+
+// Add records a sample. The first sample initializes the average directly.
+func (e *EWMA) Add(sample float64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if !e.initialized {
+		e.value = sample
+		e.initialized = true
+		return
+	}
+	e.value = e.alpha*sample + (1-e.alpha)*e.value
+}
+
+// Value returns the current average, or 0 if no samples have been added.
+func (e *EWMA) Value() float64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.value
+}
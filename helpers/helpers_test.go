@@ -1,9 +1,17 @@
 package helpers
 
 import (
+	"errors"
+	"io"
+	"net/http/httptest"
+	"sort"
 	"strings"
+	"sync"
 	"testing"
 	"time"
+
+	"github.com/budimanlai/go-pkg/types"
+	"github.com/gofiber/fiber/v2"
 )
 
 // ============================================================================
@@ -554,3 +562,696 @@ func TestNormalizePhoneNumber_EdgeCases(t *testing.T) {
 		}
 	})
 }
+
+// ============================================================================
+// Date Helper Tests
+// ============================================================================
+
+func TestParseExtendedDuration(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected time.Duration
+	}{
+		{"days", "7d", 7 * 24 * time.Hour},
+		{"weeks", "2w", 14 * 24 * time.Hour},
+		{"fractional_days", "1.5d", 36 * time.Hour},
+		{"standard_hours", "3h", 3 * time.Hour},
+		{"standard_mixed", "1h30m", 90 * time.Minute},
+		{"week_day_hour", "1w2d3h", (7+2)*24*time.Hour + 3*time.Hour},
+		{"day_minute_second", "1d10m5s", 24*time.Hour + 10*time.Minute + 5*time.Second},
+		{"negative", "-3d12h", -(3*24*time.Hour + 12*time.Hour)},
+		{"plus_sign", "+1d", 24 * time.Hour},
+		{"zero", "0", 0},
+		{"milliseconds", "1d500ms", 24*time.Hour + 500*time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseExtendedDuration(tt.input)
+			if err != nil {
+				t.Fatalf("ParseExtendedDuration(%q) returned error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("ParseExtendedDuration(%q) = %v, expected %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseExtendedDuration_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		contains string
+	}{
+		{"empty", "", "invalid duration"},
+		{"only_sign", "-", "invalid duration"},
+		{"missing_unit", "10", "missing unit"},
+		{"unknown_unit", "5y", "unknown unit"},
+		{"unknown_unit_after_valid", "1d2x", "unknown unit"},
+		{"letters_only", "abc", "expected number"},
+		{"bad_number", "1..5d", "bad number"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseExtendedDuration(tt.input)
+			if err == nil {
+				t.Fatalf("ParseExtendedDuration(%q) expected error, got nil", tt.input)
+			}
+			if !strings.Contains(err.Error(), tt.contains) {
+				t.Errorf("ParseExtendedDuration(%q) error = %q, expected to contain %q", tt.input, err.Error(), tt.contains)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// Query Helper Tests
+// ============================================================================
+
+func runQueryTest(t *testing.T, target string, handler func(c *fiber.Ctx) error) {
+	app := fiber.New()
+	app.Get("/test", handler)
+
+	req := httptest.NewRequest("GET", target, nil)
+	if _, err := app.Test(req); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestQueryInt(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		expected int
+	}{
+		{"missing", "/test", 10},
+		{"invalid", "/test?page=abc", 10},
+		{"in_range", "/test?page=5", 5},
+		{"below_min", "/test?page=-3", 1},
+		{"above_max", "/test?page=500", 100},
+		{"with_spaces", "/test?page=%207%20", 7},
+		{"empty", "/test?page=", 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result int
+			runQueryTest(t, tt.target, func(c *fiber.Ctx) error {
+				result = QueryInt(c, "page", 10, 1, 100)
+				return nil
+			})
+			if result != tt.expected {
+				t.Errorf("Expected %d, got %d", tt.expected, result)
+			}
+		})
+	}
+
+	t.Run("default_is_clamped", func(t *testing.T) {
+		var result int
+		runQueryTest(t, "/test", func(c *fiber.Ctx) error {
+			result = QueryInt(c, "page", 0, 1, 100)
+			return nil
+		})
+		if result != 1 {
+			t.Errorf("Expected 1, got %d", result)
+		}
+	})
+}
+
+func TestQueryBool(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		def      bool
+		expected bool
+	}{
+		{"missing", "/test", true, true},
+		{"invalid", "/test?active=maybe", true, true},
+		{"true", "/test?active=true", false, true},
+		{"one", "/test?active=1", false, true},
+		{"yes", "/test?active=YES", false, true},
+		{"false", "/test?active=false", true, false},
+		{"off", "/test?active=off", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result bool
+			runQueryTest(t, tt.target, func(c *fiber.Ctx) error {
+				result = QueryBool(c, "active", tt.def)
+				return nil
+			})
+			if result != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestParseBool(t *testing.T) {
+	truthy := []string{"1", "t", "true", "y", "yes", "on", "TRUE", "Yes", "On", " true "}
+	falsy := []string{"0", "f", "false", "n", "no", "off", "FALSE", "No", "OFF", " off "}
+
+	for _, s := range truthy {
+		if b, err := ParseBool(s); err != nil || !b {
+			t.Errorf("ParseBool(%q) = %v, %v; want true, nil", s, b, err)
+		}
+	}
+	for _, s := range falsy {
+		if b, err := ParseBool(s); err != nil || b {
+			t.Errorf("ParseBool(%q) = %v, %v; want false, nil", s, b, err)
+		}
+	}
+	for _, s := range []string{"", " ", "maybe", "2", "-1", "yess", "truthy", "enabled"} {
+		if _, err := ParseBool(s); err == nil {
+			t.Errorf("ParseBool(%q) expected error", s)
+		}
+	}
+}
+
+func TestParseBoolDefault(t *testing.T) {
+	if !ParseBoolDefault("on", false) {
+		t.Error("Expected 'on' to parse as true")
+	}
+	if ParseBoolDefault("no", true) {
+		t.Error("Expected 'no' to parse as false")
+	}
+	if !ParseBoolDefault("", true) {
+		t.Error("Expected default for empty input")
+	}
+	if ParseBoolDefault("maybe", false) {
+		t.Error("Expected default for invalid input")
+	}
+}
+
+func TestQueryString(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		expected string
+	}{
+		{"missing", "/test", "created_at"},
+		{"blank", "/test?sort=%20%20", "created_at"},
+		{"present", "/test?sort=name", "name"},
+		{"trimmed", "/test?sort=%20name%20", "name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result string
+			runQueryTest(t, tt.target, func(c *fiber.Ctx) error {
+				result = QueryString(c, "sort", "created_at")
+				return nil
+			})
+			if result != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// EWMA Tests
+// ============================================================================
+
+func TestEWMA(t *testing.T) {
+	t.Run("known_sequence", func(t *testing.T) {
+		e := NewEWMA(0.5)
+		expected := []float64{10, 15, 17.5, 18.75}
+		for i, sample := range []float64{10, 20, 20, 20} {
+			e.Add(sample)
+			if e.Value() != expected[i] {
+				t.Errorf("After sample %d: expected %v, got %v", i, expected[i], e.Value())
+			}
+		}
+	})
+
+	t.Run("converges_to_constant", func(t *testing.T) {
+		e := NewEWMA(0.2)
+		e.Add(100)
+		for i := 0; i < 100; i++ {
+			e.Add(50)
+		}
+		if diff := e.Value() - 50; diff > 0.001 || diff < -0.001 {
+			t.Errorf("Expected EWMA to converge to 50, got %v", e.Value())
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		if v := NewEWMA(0.5).Value(); v != 0 {
+			t.Errorf("Expected 0 before any sample, got %v", v)
+		}
+	})
+
+	t.Run("invalid_alpha_uses_default", func(t *testing.T) {
+		e := NewEWMA(0)
+		e.Add(0)
+		e.Add(10)
+		if diff := e.Value() - 1; diff > 1e-9 || diff < -1e-9 {
+			t.Errorf("Expected default alpha 0.1 to give 1, got %v", e.Value())
+		}
+	})
+
+	t.Run("concurrent_add", func(t *testing.T) {
+		e := NewEWMA(0.1)
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				e.Add(42)
+			}()
+		}
+		wg.Wait()
+		if diff := e.Value() - 42; diff > 1e-9 || diff < -1e-9 {
+			t.Errorf("Expected 42, got %v", e.Value())
+		}
+	})
+}
+
+// ============================================================================
+// Defaults Tests
+// ============================================================================
+
+func TestSetDefaults(t *testing.T) {
+	type Paging struct {
+		Page int `default:"1"`
+	}
+	type Request struct {
+		Limit   int           `default:"20"`
+		Sort    string        `default:"created_at"`
+		Active  bool          `default:"true"`
+		Ratio   float64       `default:"0.5"`
+		Timeout time.Duration `default:"30s"`
+		Since   types.UTCTime `default:"2025-01-02T03:04:05Z"`
+		Count   *int          `default:"5"`
+		Name    string
+		Paging  Paging
+	}
+
+	t.Run("fills_zero_fields", func(t *testing.T) {
+		var req Request
+		if err := SetDefaults(&req); err != nil {
+			t.Fatal(err)
+		}
+
+		if req.Limit != 20 {
+			t.Errorf("Expected Limit 20, got %d", req.Limit)
+		}
+		if req.Sort != "created_at" {
+			t.Errorf("Expected Sort 'created_at', got %q", req.Sort)
+		}
+		if !req.Active {
+			t.Error("Expected Active true")
+		}
+		if req.Ratio != 0.5 {
+			t.Errorf("Expected Ratio 0.5, got %v", req.Ratio)
+		}
+		if req.Timeout != 30*time.Second {
+			t.Errorf("Expected Timeout 30s, got %v", req.Timeout)
+		}
+		if req.Since.String() != "2025-01-02T03:04:05Z" {
+			t.Errorf("Expected Since 2025-01-02T03:04:05Z, got %v", req.Since)
+		}
+		if req.Count == nil || *req.Count != 5 {
+			t.Errorf("Expected Count 5, got %v", req.Count)
+		}
+		if req.Name != "" {
+			t.Errorf("Expected untagged Name to stay empty, got %q", req.Name)
+		}
+		if req.Paging.Page != 1 {
+			t.Errorf("Expected nested Page 1, got %d", req.Paging.Page)
+		}
+	})
+
+	t.Run("keeps_non_zero_fields", func(t *testing.T) {
+		req := Request{Limit: 50, Sort: "name", Count: Pointer(0)}
+		if err := SetDefaults(&req); err != nil {
+			t.Fatal(err)
+		}
+
+		if req.Limit != 50 {
+			t.Errorf("Expected Limit to stay 50, got %d", req.Limit)
+		}
+		if req.Sort != "name" {
+			t.Errorf("Expected Sort to stay 'name', got %q", req.Sort)
+		}
+		if *req.Count != 0 {
+			t.Errorf("Expected explicit Count 0 to stay, got %d", *req.Count)
+		}
+	})
+
+	t.Run("invalid_default", func(t *testing.T) {
+		type Bad struct {
+			Limit int `default:"abc"`
+		}
+		if err := SetDefaults(&Bad{}); err == nil {
+			t.Error("Expected error for invalid default")
+		}
+	})
+
+	t.Run("non_pointer", func(t *testing.T) {
+		if err := SetDefaults(Request{}); err == nil {
+			t.Error("Expected error for non-pointer argument")
+		}
+	})
+}
+
+func TestSet(t *testing.T) {
+	sorted := func(s *Set[int]) []int {
+		items := s.Items()
+		sort.Ints(items)
+		return items
+	}
+	equal := func(a, b []int) bool {
+		if len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if a[i] != b[i] {
+				return false
+			}
+		}
+		return true
+	}
+
+	t.Run("from_slice_with_duplicates", func(t *testing.T) {
+		s := NewSet([]int{3, 1, 3, 2, 1}...)
+		if s.Len() != 3 {
+			t.Errorf("Expected 3 items, got %d", s.Len())
+		}
+		if got := sorted(s); !equal(got, []int{1, 2, 3}) {
+			t.Errorf("Expected [1 2 3], got %v", got)
+		}
+	})
+
+	t.Run("add_remove_contains", func(t *testing.T) {
+		s := NewSet[string]()
+		s.Add("id", "en")
+		s.Add("id")
+		if s.Len() != 2 || !s.Contains("id") || !s.Contains("en") {
+			t.Errorf("Expected set with id and en, got %v", s.Items())
+		}
+
+		s.Remove("en", "fr")
+		if s.Contains("en") || s.Len() != 1 {
+			t.Errorf("Expected en to be removed, got %v", s.Items())
+		}
+	})
+
+	t.Run("set_operations", func(t *testing.T) {
+		a := NewSet(1, 2, 3)
+		b := NewSet(2, 3, 4)
+
+		if got := sorted(a.Union(b)); !equal(got, []int{1, 2, 3, 4}) {
+			t.Errorf("Union: expected [1 2 3 4], got %v", got)
+		}
+		if got := sorted(a.Intersect(b)); !equal(got, []int{2, 3}) {
+			t.Errorf("Intersect: expected [2 3], got %v", got)
+		}
+		if got := sorted(a.Difference(b)); !equal(got, []int{1}) {
+			t.Errorf("Difference: expected [1], got %v", got)
+		}
+		if got := sorted(a.Difference(a)); len(got) != 0 {
+			t.Errorf("Difference with itself: expected empty set, got %v", got)
+		}
+
+		// Operations return new sets and leave the operands untouched
+		if a.Len() != 3 || b.Len() != 3 {
+			t.Errorf("Expected operands to be unchanged, got %v and %v", a.Items(), b.Items())
+		}
+	})
+
+	t.Run("zero_value", func(t *testing.T) {
+		var s Set[int]
+		if s.Len() != 0 || s.Contains(1) || len(s.Items()) != 0 {
+			t.Errorf("Expected empty zero-value set, got %v", s.Items())
+		}
+		s.Remove(1)
+		if got := sorted(s.Union(NewSet(2))); !equal(got, []int{2}) {
+			t.Errorf("Union with zero value: expected [2], got %v", got)
+		}
+
+		s.Add(1, 2)
+		if got := sorted(&s); !equal(got, []int{1, 2}) {
+			t.Errorf("Expected [1 2] after Add on zero value, got %v", got)
+		}
+	})
+}
+
+func TestChain(t *testing.T) {
+	t.Run("runs_in_order", func(t *testing.T) {
+		var order []string
+		step := func(name string) fiber.Handler {
+			return func(c *fiber.Ctx) error {
+				order = append(order, name+":before")
+				err := c.Next()
+				order = append(order, name+":after")
+				return err
+			}
+		}
+
+		app := fiber.New()
+		app.Use(Chain(step("a"), step("b")))
+		app.Use(step("c"))
+		app.Get("/test", func(c *fiber.Ctx) error {
+			order = append(order, "handler")
+			return c.SendString("ok")
+		})
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/test", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != fiber.StatusOK {
+			t.Errorf("Expected status 200, got %d", resp.StatusCode)
+		}
+
+		expected := "a:before,b:before,c:before,handler,c:after,b:after,a:after"
+		if got := strings.Join(order, ","); got != expected {
+			t.Errorf("Expected order %s, got %s", expected, got)
+		}
+	})
+
+	t.Run("short_circuit", func(t *testing.T) {
+		var calls []string
+		abort := func(c *fiber.Ctx) error {
+			calls = append(calls, "abort")
+			return c.Status(fiber.StatusUnauthorized).SendString("denied")
+		}
+		never := func(c *fiber.Ctx) error {
+			calls = append(calls, "never")
+			return c.Next()
+		}
+
+		app := fiber.New()
+		app.Use(Chain(abort, never))
+		app.Get("/test", func(c *fiber.Ctx) error {
+			calls = append(calls, "handler")
+			return c.SendString("ok")
+		})
+
+		resp, _ := app.Test(httptest.NewRequest("GET", "/test", nil))
+		if resp.StatusCode != fiber.StatusUnauthorized {
+			t.Errorf("Expected status 401, got %d", resp.StatusCode)
+		}
+		if got := strings.Join(calls, ","); got != "abort" {
+			t.Errorf("Expected only the aborting handler to run, got %s", got)
+		}
+	})
+
+	t.Run("shares_locals_and_errors", func(t *testing.T) {
+		setUser := func(c *fiber.Ctx) error {
+			c.Locals("user", "alice")
+			return c.Next()
+		}
+		var seenByChain error
+		observe := func(c *fiber.Ctx) error {
+			seenByChain = c.Next()
+			return seenByChain
+		}
+
+		app := fiber.New()
+		app.Use(Chain(setUser, observe))
+		app.Get("/ok", func(c *fiber.Ctx) error {
+			return c.SendString(c.Locals("user").(string))
+		})
+		app.Get("/fail", func(c *fiber.Ctx) error {
+			return fiber.NewError(fiber.StatusTeapot, "teapot")
+		})
+
+		resp, _ := app.Test(httptest.NewRequest("GET", "/ok", nil))
+		body, _ := io.ReadAll(resp.Body)
+		if string(body) != "alice" {
+			t.Errorf("Expected locals from the chain, got %q", body)
+		}
+
+		resp, _ = app.Test(httptest.NewRequest("GET", "/fail", nil))
+		if resp.StatusCode != fiber.StatusTeapot {
+			t.Errorf("Expected downstream error to reach the app error handler, got %d", resp.StatusCode)
+		}
+		if seenByChain == nil {
+			t.Error("Expected handlers in the chain to observe the downstream error")
+		}
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		var order []string
+		step := func(name string) fiber.Handler {
+			return func(c *fiber.Ctx) error {
+				order = append(order, name)
+				return c.Next()
+			}
+		}
+
+		app := fiber.New()
+		app.Use(Chain(step("a"), Chain(step("b"), step("c")), step("d")))
+		app.Get("/test", func(c *fiber.Ctx) error {
+			order = append(order, "handler")
+			return c.SendString("ok")
+		})
+
+		app.Test(httptest.NewRequest("GET", "/test", nil))
+		if got := strings.Join(order, ","); got != "a,b,c,d,handler" {
+			t.Errorf("Expected a,b,c,d,handler, got %s", got)
+		}
+	})
+
+	t.Run("route_params", func(t *testing.T) {
+		var param, path string
+		capture := func(c *fiber.Ctx) error {
+			param, path = c.Params("id"), c.Route().Path
+			return c.Next()
+		}
+
+		app := fiber.New()
+		app.Get("/users/:id", Chain(capture), func(c *fiber.Ctx) error {
+			return c.SendString(c.Params("id"))
+		})
+
+		resp, _ := app.Test(httptest.NewRequest("GET", "/users/42", nil))
+		body, _ := io.ReadAll(resp.Body)
+		if string(body) != "42" {
+			t.Errorf("Expected downstream params to be kept, got %q", body)
+		}
+		if param != "42" || path != "/users/:id" {
+			t.Errorf("Expected params and route inside the chain, got %q and %q", param, path)
+		}
+	})
+
+	t.Run("per_app_config", func(t *testing.T) {
+		var appName string
+		common := Chain(func(c *fiber.Ctx) error {
+			appName = c.App().Config().AppName
+			return c.Next()
+		})
+
+		// A shared chain must follow the config of the app serving each request
+		for _, name := range []string{"first", "second"} {
+			app := fiber.New(fiber.Config{AppName: name})
+			app.Use(common)
+			app.Get("/test", func(c *fiber.Ctx) error {
+				return c.SendString("ok")
+			})
+
+			app.Test(httptest.NewRequest("GET", "/test", nil))
+			if appName != name {
+				t.Errorf("Expected config of app %q, got %q", name, appName)
+			}
+		}
+	})
+
+	t.Run("same_chain_twice", func(t *testing.T) {
+		calls := 0
+		common := Chain(func(c *fiber.Ctx) error {
+			calls++
+			return c.Next()
+		})
+
+		app := fiber.New()
+		app.Use(common)
+		app.Get("/test", common, func(c *fiber.Ctx) error {
+			return fiber.NewError(fiber.StatusTeapot, "teapot")
+		})
+
+		resp, _ := app.Test(httptest.NewRequest("GET", "/test", nil))
+		if resp.StatusCode != fiber.StatusTeapot {
+			t.Errorf("Expected downstream error through both chains, got %d", resp.StatusCode)
+		}
+		if calls != 2 {
+			t.Errorf("Expected the chain to run twice, got %d", calls)
+		}
+	})
+}
+
+// ============================================================================
+// Recover Tests
+// ============================================================================
+
+func TestRecover(t *testing.T) {
+	t.Run("normal_return", func(t *testing.T) {
+		if err := Recover(func() error { return nil }); err != nil {
+			t.Errorf("Expected nil, got %v", err)
+		}
+	})
+
+	t.Run("error_return", func(t *testing.T) {
+		want := errors.New("write failed")
+		if err := Recover(func() error { return want }); err != want {
+			t.Errorf("Expected %v, got %v", want, err)
+		}
+	})
+
+	t.Run("panic_converted", func(t *testing.T) {
+		err := Recover(func() error { panic("boom") })
+
+		var panicErr *PanicError
+		if !errors.As(err, &panicErr) {
+			t.Fatalf("Expected *PanicError, got %T: %v", err, err)
+		}
+		if panicErr.Value != "boom" {
+			t.Errorf("Expected panic value 'boom', got %v", panicErr.Value)
+		}
+		if err.Error() != "panic: boom" {
+			t.Errorf("Unexpected error message: %s", err.Error())
+		}
+		if !strings.Contains(string(panicErr.Stack), "TestRecover") {
+			t.Errorf("Expected stack trace to be attached, got %s", panicErr.Stack)
+		}
+	})
+
+	t.Run("panic_with_error_unwraps", func(t *testing.T) {
+		cause := errors.New("nil map")
+		err := Recover(func() error { panic(cause) })
+		if !errors.Is(err, cause) {
+			t.Errorf("Expected error to unwrap to panic value, got %v", err)
+		}
+	})
+}
+
+func TestSafeGo(t *testing.T) {
+	if err := <-SafeGo(func() error { return nil }); err != nil {
+		t.Errorf("Expected nil, got %v", err)
+	}
+
+	want := errors.New("audit write failed")
+	if err := <-SafeGo(func() error { return want }); err != want {
+		t.Errorf("Expected %v, got %v", want, err)
+	}
+
+	done := SafeGo(func() error {
+		var m map[string]int
+		m["x"] = 1 // panics
+		return nil
+	})
+	var panicErr *PanicError
+	if err := <-done; !errors.As(err, &panicErr) {
+		t.Errorf("Expected *PanicError, got %v", err)
+	}
+	if _, open := <-done; open {
+		t.Error("Expected channel to be closed after the result")
+	}
+}
@@ -0,0 +1,85 @@
+package helpers
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// QueryInt parses an integer query parameter, falling back to a default and clamping to bounds.
+// If the parameter is missing or not a valid integer, def is used. The result is then
+// clamped to the range [min, max].
+//
+// Parameters:
+//   - c: *fiber.Ctx - The Fiber context
+//   - key: Name of the query parameter
+//   - def: Default value used when the parameter is missing or invalid
+//   - min: Lower bound of the returned value
+//   - max: Upper bound of the returned value
+//
+// Returns:
+//   - int: Parsed and clamped value
+//
+// Example:
+//
+//	// GET /users?page=3&limit=500
+//	page := QueryInt(c, "page", 1, 1, 1000)    // Returns: 3
+//	limit := QueryInt(c, "limit", 20, 1, 100)  // Returns: 100
+func QueryInt(c *fiber.Ctx, key string, def, min, max int) int {
+	value := def
+	if raw := strings.TrimSpace(c.Query(key)); raw != "" {
+		if parsed, err := strconv.Atoi(raw); err == nil {
+			value = parsed
+		}
+	}
+
+	if value < min {
+		return min
+	}
+	if value > max {
+		return max
+	}
+	return value
+}
+
+// QueryBool parses a boolean query parameter, falling back to a default.
+// Accepted values are those understood by ParseBool (1, true, yes, on, 0, false, no, off, ...).
+//
+// Parameters:
+//   - c: *fiber.Ctx - The Fiber context
+//   - key: Name of the query parameter
+//   - def: Default value used when the parameter is missing or invalid
+//
+// Returns:
+//   - bool: Parsed value or def
+//
+// Example:
+//
+//	// GET /users?active=true
+//	active := QueryBool(c, "active", false)  // Returns: true
+func QueryBool(c *fiber.Ctx, key string, def bool) bool {
+	return ParseBoolDefault(c.Query(key), def)
+}
+
+// QueryString returns a trimmed query parameter, falling back to a default when it is missing or blank.
+//
+// Parameters:
+//   - c: *fiber.Ctx - The Fiber context
+//   - key: Name of the query parameter
+//   - def: Default value used when the parameter is missing or blank
+//
+// Returns:
+//   - string: Trimmed value or def
+//
+// Example:
+//
+//	// GET /users?sort=name
+//	sort := QueryString(c, "sort", "created_at")  // Returns: name
+func QueryString(c *fiber.Ctx, key string, def string) string {
+	raw := strings.TrimSpace(c.Query(key))
+	if raw == "" {
+		return def
+	}
+	return raw
+}
@@ -0,0 +1,81 @@
+package helpers
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// PanicError is returned by Recover and SafeGo when the function panicked.
+// It carries the recovered value and the stack trace of the panicking goroutine.
+type PanicError struct {
+	Value interface{} // Value passed to panic()
+	Stack []byte      // Stack trace captured when the panic was recovered
+}
+
+// Error returns the panic value; the stack trace is available in Stack.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the panic value if it is an error, so errors.Is and errors.As see through it.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// Recover runs fn and converts a panic into a returned *PanicError with the stack attached.
+// If fn returns normally, its error is returned unchanged.
+//
+// Parameters:
+//   - fn: Function to run
+//
+// Returns:
+//   - error: fn's error, a *PanicError if fn panicked, or nil
+//
+// Example:
+//
+//	err := Recover(func() error {
+//	    return hook.Run(event)
+//	})
+//	var panicErr *PanicError
+//	if errors.As(err, &panicErr) {
+//	    logger.Errorf("hook panicked: %v\n%s", panicErr.Value, panicErr.Stack)
+//	}
+func Recover(fn func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &PanicError{Value: r, Stack: debug.Stack()}
+		}
+	}()
+	return fn()
+}
+
+// SafeGo runs fn in a new goroutine with Recover, so a panic cannot crash the application.
+// The returned channel receives fn's result (nil, its error, or a *PanicError) and is then closed.
+// It is buffered, so callers that do not care about the result may ignore it.
+//
+// Parameters:
+//   - fn: Function to run in the background
+//
+// Returns:
+//   - <-chan error: Channel delivering the single result
+//
+// Example:
+//
+//	// Fire and forget
+//	SafeGo(func() error { return audit.Write(entry) })
+//
+//	// Wait for the result
+//	if err := <-SafeGo(sendMetrics); err != nil {
+//	    logger.Errorf("metrics failed: %v", err)
+//	}
+func SafeGo(fn func() error) <-chan error {
+	done := make(chan error, 1)
+	go func() {
+		defer close(done)
+		done <- Recover(fn)
+	}()
+	return done
+}
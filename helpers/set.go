@@ -0,0 +1,100 @@
+package helpers
+
+// Set is an unordered collection of unique comparable values backed by a map.
+// The zero value is an empty set ready to use.
+// It is not safe for concurrent use; guard it with a mutex when shared between goroutines.
+type Set[T comparable] struct {
+	items map[T]struct{}
+}
+
+// NewSet creates a set containing the given items. Duplicates are stored once.
+//
+// Parameters:
+//   - items: Initial members of the set
+//
+// Returns:
+//   - *Set[T]: The new set
+//
+// Example:
+//
+//	allowed := NewSet("id", "en", "en")
+//	allowed.Len()           // 2
+//	allowed.Contains("id")  // true
+func NewSet[T comparable](items ...T) *Set[T] {
+	s := &Set[T]{items: make(map[T]struct{}, len(items))}
+	for _, item := range items {
+		s.items[item] = struct{}{}
+	}
+	return s
+}
+
+// Add inserts the items into the set.
+func (s *Set[T]) Add(items ...T) {
+	if s.items == nil {
+		s.items = make(map[T]struct{}, len(items))
+	}
+	for _, item := range items {
+		s.items[item] = struct{}{}
+	}
+}
+
+// Remove deletes the items from the set. Items that are not members are ignored.
+func (s *Set[T]) Remove(items ...T) {
+	for _, item := range items {
+		delete(s.items, item)
+	}
+}
+
+// Contains reports whether item is a member of the set.
+func (s *Set[T]) Contains(item T) bool {
+	_, ok := s.items[item]
+	return ok
+}
+
+// Len returns the number of items in the set.
+func (s *Set[T]) Len() int {
+	return len(s.items)
+}
+
+// Items returns the members as a slice. The order is unspecified.
+func (s *Set[T]) Items() []T {
+	items := make([]T, 0, len(s.items))
+	for item := range s.items {
+		items = append(items, item)
+	}
+	return items
+}
+
+// Union returns a new set with the items that are in s or other.
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	result := NewSet[T]()
+	for item := range s.items {
+		result.items[item] = struct{}{}
+	}
+	for item := range other.items {
+		result.items[item] = struct{}{}
+	}
+	return result
+}
+
+// Intersect returns a new set with the items that are in both s and other.
+func (s *Set[T]) Intersect(other *Set[T]) *Set[T] {
+	result := NewSet[T]()
+	for item := range s.items {
+		if other.Contains(item) {
+			result.items[item] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Difference returns a new set with the items that are in s but not in other.
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	result := NewSet[T]()
+	for item := range s.items {
+		if !other.Contains(item) {
+			result.items[item] = struct{}{}
+		}
+	}
+	return result
+}
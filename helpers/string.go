@@ -1,10 +1,12 @@
 package helpers
 
 import (
+	crand "crypto/rand"
 	"fmt"
 	"math/rand"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -77,6 +79,76 @@ func GenerateMessageID() string {
 	return uuid.New().String()
 }
 
+// crockfordAlphabet is the Crockford base32 alphabet used by ULIDs.
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+var (
+	ulidMu          sync.Mutex
+	ulidLastTime    uint64
+	ulidLastEntropy [10]byte
+)
+
+// GenerateULID generates a lexicographically sortable, time-ordered ID following the ULID spec.
+// The ID is 26 Crockford base32 characters: a 48-bit millisecond timestamp followed by
+// 80 bits of entropy from crypto/rand. IDs generated within the same millisecond are kept
+// monotonic by incrementing the previous entropy, so sequential IDs always sort in order.
+//
+// Time-ordered IDs improve insert locality when used as database primary keys.
+//
+// Returns:
+//   - string: A 26-character ULID
+//
+// Example:
+//
+//	id := GenerateULID()
+//	// Output: 01JA8ZK5Q3X4Y7RMN2B6C9D0EF
+func GenerateULID() string {
+	ms := uint64(time.Now().UnixMilli())
+
+	ulidMu.Lock()
+	if ms <= ulidLastTime {
+		// Same (or earlier) millisecond: increment the previous entropy to stay monotonic
+		ms = ulidLastTime
+		for i := len(ulidLastEntropy) - 1; i >= 0; i-- {
+			ulidLastEntropy[i]++
+			if ulidLastEntropy[i] != 0 {
+				break
+			}
+		}
+	} else {
+		if _, err := crand.Read(ulidLastEntropy[:]); err != nil {
+			panic(fmt.Sprintf("failed to read random bytes: %v", err))
+		}
+		ulidLastTime = ms
+	}
+
+	var id [16]byte
+	id[0] = byte(ms >> 40)
+	id[1] = byte(ms >> 32)
+	id[2] = byte(ms >> 24)
+	id[3] = byte(ms >> 16)
+	id[4] = byte(ms >> 8)
+	id[5] = byte(ms)
+	copy(id[6:], ulidLastEntropy[:])
+	ulidMu.Unlock()
+
+	// Encode 128 bits as 26 base32 characters (130 bits, with 2 leading zero bits)
+	out := make([]byte, 26)
+	for i := range out {
+		var v byte
+		for b := 0; b < 5; b++ {
+			bit := i*5 + b - 2
+			v <<= 1
+			if bit >= 0 && id[bit/8]&(0x80>>(bit%8)) != 0 {
+				v |= 1
+			}
+		}
+		out[i] = crockfordAlphabet[v]
+	}
+
+	return string(out)
+}
+
 // GenerateUniqueID generates a short unique ID string by extracting the first 8 characters of a UUID v4.
 // This provides a shorter identifier while maintaining reasonable uniqueness for most use cases.
 //
@@ -171,3 +243,36 @@ func GenerateRandomNumberString(length int) string {
 	}
 	return string(b)
 }
+
+// Truncate shortens a string to at most maxRunes characters, counting runes instead of bytes.
+// The ellipsis is appended only when the string is actually truncated, and a multibyte
+// character is never split in the middle.
+//
+// Parameters:
+//   - s: The string to truncate
+//   - maxRunes: Maximum number of runes to keep from s (negative values are treated as 0)
+//   - ellipsis: Suffix appended when truncation happens (e.g., "..." or "…")
+//
+// Returns:
+//   - string: The original string if it fits, otherwise the first maxRunes runes followed by ellipsis
+//
+// Example:
+//
+//	Truncate("Hello, World", 5, "...")  // Returns: Hello...
+//	Truncate("こんにちは世界", 5, "…")     // Returns: こんにちは…
+//	Truncate("Hi", 5, "...")            // Returns: Hi
+func Truncate(s string, maxRunes int, ellipsis string) string {
+	if maxRunes < 0 {
+		maxRunes = 0
+	}
+
+	count := 0
+	for i := range s {
+		if count == maxRunes {
+			return s[:i] + ellipsis
+		}
+		count++
+	}
+
+	return s
+}
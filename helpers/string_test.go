@@ -3,6 +3,8 @@ package helpers
 import (
 	"strings"
 	"testing"
+	"time"
+	"unicode/utf8"
 )
 
 func TestNormalizePhoneNumber1(t *testing.T) {
@@ -90,3 +92,81 @@ func TestNormalizePhoneNumber_EdgeCases1(t *testing.T) {
 		}
 	})
 }
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		maxRunes int
+		ellipsis string
+		expected string
+	}{
+		{"ascii_truncated", "Hello, World", 5, "...", "Hello..."},
+		{"ascii_exact_length", "Hello", 5, "...", "Hello"},
+		{"ascii_shorter_than_limit", "Hi", 5, "...", "Hi"},
+		{"ascii_zero_limit", "Hello", 0, "...", "..."},
+		{"negative_limit", "Hello", -1, "...", "..."},
+		{"empty_string", "", 3, "...", ""},
+		{"empty_ellipsis", "Hello, World", 5, "", "Hello"},
+		{"emoji_truncated", "😀😃😄😁😆", 2, "…", "😀😃…"},
+		{"emoji_exact_length", "😀😃😄", 3, "…", "😀😃😄"},
+		{"cjk_truncated", "こんにちは世界", 5, "…", "こんにちは…"},
+		{"cjk_one_rune", "你好世界", 1, "...", "你..."},
+		{"cjk_not_truncated", "你好世界", 10, "...", "你好世界"},
+		{"mixed_ascii_multibyte", "abc日本語def", 4, "..", "abc日.."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Truncate(tt.input, tt.maxRunes, tt.ellipsis)
+			if result != tt.expected {
+				t.Errorf("Truncate(%q, %d, %q) = %q, expected %q", tt.input, tt.maxRunes, tt.ellipsis, result, tt.expected)
+			}
+			if !utf8.ValidString(result) {
+				t.Errorf("Truncate(%q, %d, %q) produced invalid UTF-8: %q", tt.input, tt.maxRunes, tt.ellipsis, result)
+			}
+		})
+	}
+}
+
+func TestGenerateULID(t *testing.T) {
+	t.Run("length_and_alphabet", func(t *testing.T) {
+		id := GenerateULID()
+		if len(id) != 26 {
+			t.Errorf("Expected length 26, got %d", len(id))
+		}
+		for _, ch := range id {
+			if !strings.ContainsRune(crockfordAlphabet, ch) {
+				t.Errorf("Unexpected character %q in ULID %s", ch, id)
+			}
+		}
+		// The first character can only encode the top 3 bits of the timestamp
+		if id[0] > '7' {
+			t.Errorf("Expected first character <= '7', got %q", id[0])
+		}
+	})
+
+	t.Run("monotonic_in_sequence", func(t *testing.T) {
+		prev := GenerateULID()
+		for i := 0; i < 10000; i++ {
+			next := GenerateULID()
+			if next <= prev {
+				t.Fatalf("Expected %s > %s at iteration %d", next, prev, i)
+			}
+			prev = next
+		}
+	})
+
+	t.Run("timestamp_prefix", func(t *testing.T) {
+		before := time.Now().UnixMilli()
+		id := GenerateULID()
+
+		var ms int64
+		for _, ch := range id[:10] {
+			ms = ms<<5 | int64(strings.IndexRune(crockfordAlphabet, ch))
+		}
+		if ms < before || ms > time.Now().UnixMilli()+1000 {
+			t.Errorf("Unexpected timestamp %d decoded from %s", ms, id)
+		}
+	})
+}
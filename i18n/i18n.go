@@ -4,6 +4,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/fs"
+	"path"
+	"reflect"
+	"strings"
+	"sync"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/log"
@@ -19,6 +24,13 @@ import (
 //   - SupportedLangs: List of supported language codes (e.g., ["en", "id", "zh"])
 //   - LocalesPath: Path to the directory containing locale files (default: "locales")
 //   - Modules: Optional list of module names for modular locale files
+//   - LangHeaderName: Optional custom header (e.g., "X-App-Language") checked before any other source
+//   - LanguageCookie: Optional cookie name (e.g., "lang") holding the user's saved language choice,
+//     checked after the query parameter and before Accept-Language
+//   - OnMissing: Optional hook called with the requested language and message ID when Translate,
+//     TranslatePlural or TranslateWithConfig find a message in neither that language nor the default
+//     language (e.g., to count untranslated keys). TranslateOrError does not call it, since its
+//     callers handle the missing message themselves
 //
 // Example:
 //
@@ -27,25 +39,40 @@ import (
 //	    SupportedLangs:  []string{"en", "id", "zh"},
 //	    LocalesPath:     "locales",
 //	    Modules:         []string{"auth", "user", "product"},
+//	    LangHeaderName:  "X-App-Language",
+//	    LanguageCookie:  "lang",
 //	}
 type I18nConfig struct {
 	DefaultLanguage language.Tag
 	SupportedLangs  []string
 	LocalesPath     string
 	Modules         []string
+	LangHeaderName  string
+	LanguageCookie  string
+	OnMissing       func(lang, messageID string)
 }
 
+// ErrMissingTranslation is returned by TranslateOrError when a message is found in neither
+// the requested language nor the default language.
+var ErrMissingTranslation = errors.New("missing translation")
+
 // I18nManager manages internationalization operations including translation bundles and localizers.
 // It maintains a cache of localizers for each language to improve performance.
 //
 // Fields:
-//   - Bundle: The i18n bundle containing all loaded message files
+//   - Bundle: The i18n bundle containing all loaded message files (replaced by Reload)
 //   - Localizer: Map of language codes to their respective localizer instances
+//     (guarded by an internal lock; use the manager's methods rather than writing to it directly)
 //   - DefaultLanguage: The default language code as string
 type I18nManager struct {
 	Bundle          *i18n.Bundle
 	Localizer       map[string]*i18n.Localizer
 	DefaultLanguage string
+
+	config I18nConfig                                // configuration the bundle was loaded from, used by Reload
+	fsys   fs.FS                                     // filesystem the locale files are read from; nil means the OS filesystem
+	added  map[language.Tag]map[string]*i18n.Message // messages from AddMessages by ID, re-applied by Reload
+	mu     sync.RWMutex                              // guards Bundle, Localizer and added
 }
 
 // NewI18nManagerWithFiber creates a new I18nManager and automatically registers
@@ -75,10 +102,11 @@ type I18nManager struct {
 func NewI18nManagerWithFiber(app *fiber.App, i18nConfig I18nConfig) (*I18nManager, error) {
 	i18nManager, err := NewI18nManager(i18nConfig)
 	if err != nil {
-		return nil, errors.New("failed to initialize i18n")
+		return nil, fmt.Errorf("failed to initialize i18n: %w", err)
 	}
 
-	// Add i18n middleware
+	// Add i18n middleware, accepting only the languages actually loaded into the bundle
+	i18nConfig.SupportedLangs = i18nManager.SupportedLanguages()
 	app.Use(I18nMiddleware(i18nConfig))
 	return i18nManager, nil
 }
@@ -95,7 +123,7 @@ func NewI18nManagerWithFiber(app *fiber.App, i18nConfig I18nConfig) (*I18nManage
 //
 // Returns:
 //   - *I18nManager: Initialized I18nManager instance
-//   - error: Error if locale files cannot be loaded
+//   - error: Error naming the language and file if a locale file is missing or malformed
 //
 // Example (Flat structure):
 //
@@ -116,30 +144,201 @@ func NewI18nManagerWithFiber(app *fiber.App, i18nConfig I18nConfig) (*I18nManage
 //	}
 //	manager, err := NewI18nManager(config)
 func NewI18nManager(config I18nConfig) (*I18nManager, error) {
+	return newI18nManager(nil, config)
+}
+
+// newBundle creates an empty bundle for the configured default language that reads JSON files
+func newBundle(config I18nConfig) *i18n.Bundle {
 	bundle := i18n.NewBundle(config.DefaultLanguage)
+	bundle.RegisterUnmarshalFunc("json", json.Unmarshal)
+	return bundle
+}
+
+// localeFile is a locale file path and the language it provides
+type localeFile struct {
+	lang string
+	path string
+}
+
+// localeFiles lists the locale files to load for config:
+// locales/{lang}.json (flat) or locales/{lang}/{module}.json (modular)
+func localeFiles(config I18nConfig) []localeFile {
+	var files []localeFile
+	for _, lang := range config.SupportedLangs {
+		if len(config.Modules) == 0 {
+			files = append(files, localeFile{lang, fmt.Sprintf("%s/%s.json", config.LocalesPath, lang)})
+			continue
+		}
+		for _, module := range config.Modules {
+			files = append(files, localeFile{lang, fmt.Sprintf("%s/%s/%s.json", config.LocalesPath, lang, module)})
+		}
+	}
+	return files
+}
 
+// NewI18nManagerFromFS creates an I18nManager that loads its locale files from fsys instead of
+// the working directory, e.g. an embed.FS so the binary does not need a locales/ directory at runtime.
+// Paths are resolved the same way as in NewI18nManager, relative to the root of fsys.
+//
+// Parameters:
+//   - fsys: fs.FS - Filesystem containing the locale files (e.g., embed.FS, os.DirFS)
+//   - config: I18nConfig - Configuration with locale paths and supported languages
+//
+// Returns:
+//   - *I18nManager: Initialized I18nManager instance
+//   - error: Error if a locale file cannot be read or parsed
+//
+// Example:
+//
+//	//go:embed locales/*
+//	var localesFS embed.FS
+//
+//	manager, err := i18n.NewI18nManagerFromFS(localesFS, i18n.I18nConfig{
+//	    DefaultLanguage: language.English,
+//	    SupportedLangs:  []string{"en", "id"},
+//	    LocalesPath:     "locales",
+//	})
+func NewI18nManagerFromFS(fsys fs.FS, config I18nConfig) (*I18nManager, error) {
+	return newI18nManager(fsys, config)
+}
+
+// newI18nManager creates a manager and loads its bundle from fsys, or from the OS filesystem if fsys is nil
+func newI18nManager(fsys fs.FS, config I18nConfig) (*I18nManager, error) {
 	if config.LocalesPath == "" {
 		config.LocalesPath = "locales"
 	}
 
-	bundle.RegisterUnmarshalFunc("json", json.Unmarshal)
-	if len(config.Modules) == 0 {
-		for _, lang := range config.SupportedLangs {
-			bundle.MustLoadMessageFile(fmt.Sprintf("%s/%s.json", config.LocalesPath, lang))
+	m := &I18nManager{
+		Localizer:       make(map[string]*i18n.Localizer),
+		DefaultLanguage: config.DefaultLanguage.String(),
+		config:          config,
+		fsys:            fsys,
+	}
+
+	bundle, err := m.loadBundle()
+	if err != nil {
+		return nil, err
+	}
+	m.Bundle = bundle
+	return m, nil
+}
+
+// loadBundle builds a new bundle from the configured locale files, read from fsys when set
+func (m *I18nManager) loadBundle() (*i18n.Bundle, error) {
+	bundle := newBundle(m.config)
+	for _, file := range localeFiles(m.config) {
+		var err error
+		if m.fsys != nil {
+			// fs.FS paths must be unrooted and clean, e.g. "./locales" -> "locales"
+			_, err = bundle.LoadMessageFileFS(m.fsys, path.Clean(file.path))
+		} else {
+			_, err = bundle.LoadMessageFile(file.path)
 		}
-	} else {
-		for _, lang := range config.SupportedLangs {
-			for _, module := range config.Modules {
-				bundle.MustLoadMessageFile(fmt.Sprintf("%s/%s/%s.json", config.LocalesPath, lang, module))
-			}
+		if err != nil {
+			return nil, fmt.Errorf("failed to load %q locale file %s: %w", file.lang, file.path, err)
 		}
 	}
+	return bundle, nil
+}
 
-	return &I18nManager{
-		Bundle:          bundle,
-		Localizer:       make(map[string]*i18n.Localizer),
-		DefaultLanguage: config.DefaultLanguage.String(),
-	}, nil
+// languageTags returns a copy of the bundle's language tags; Reload and AddMessages may change them concurrently
+func (m *I18nManager) languageTags() []language.Tag {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append([]language.Tag(nil), m.Bundle.LanguageTags()...)
+}
+
+// SupportedLanguages returns the language codes loaded into the bundle, in load order.
+// Use it instead of duplicating the configured language list in handlers.
+//
+// Returns:
+//   - []string: Loaded language codes (e.g., ["en", "id", "zh"])
+//
+// Example:
+//
+//	app.Get("/languages", func(c *fiber.Ctx) error {
+//	    return c.JSON(manager.SupportedLanguages())
+//	})
+func (m *I18nManager) SupportedLanguages() []string {
+	tags := m.languageTags()
+	langs := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		langs = append(langs, tag.String())
+	}
+	return langs
+}
+
+// HasLanguage reports whether translations for lang are loaded into the bundle.
+// The comparison ignores case and surrounding whitespace.
+//
+// Parameters:
+//   - lang: Language code to check (e.g., "id")
+//
+// Returns:
+//   - bool: true if the language is loaded, false otherwise
+//
+// Example:
+//
+//	if !manager.HasLanguage(req.Language) {
+//	    return response.BadRequest(c, "Unsupported language")
+//	}
+func (m *I18nManager) HasLanguage(lang string) bool {
+	lang = strings.TrimSpace(lang)
+	for _, tag := range m.languageTags() {
+		if strings.EqualFold(tag.String(), lang) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddMessages adds messages to the bundle at runtime, e.g. translations stored in a database,
+// layered on top of the file-based messages: an existing message ID is overridden.
+// Added messages are kept across Reload. Message values may use template syntax ({{.Name}}).
+//
+// Parameters:
+//   - lang: Language code of the messages (e.g., "id")
+//   - messages: Map of message ID to translation
+//
+// Returns:
+//   - error: Error if lang is not a valid language tag
+//
+// Example:
+//
+//	rows, _ := repo.TranslationsFor("id")
+//	if err := manager.AddMessages("id", rows); err != nil {
+//	    log.Printf("failed to add translations: %v", err)
+//	}
+func (m *I18nManager) AddMessages(lang string, messages map[string]string) error {
+	tag, err := language.Parse(lang)
+	if err != nil {
+		return fmt.Errorf("invalid language %q: %w", lang, err)
+	}
+
+	parsed := make([]*i18n.Message, 0, len(messages))
+	for id, text := range messages {
+		parsed = append(parsed, &i18n.Message{ID: id, Other: text})
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.Bundle.AddMessages(tag, parsed...); err != nil {
+		return err
+	}
+	if m.added == nil {
+		m.added = make(map[language.Tag]map[string]*i18n.Message)
+	}
+	if m.added[tag] == nil {
+		m.added[tag] = make(map[string]*i18n.Message)
+	}
+	for _, msg := range parsed {
+		m.added[tag][msg.ID] = msg
+	}
+
+	// Drop cached localizers for the language so the new messages are used
+	delete(m.Localizer, lang)
+	delete(m.Localizer, tag.String())
+	return nil
 }
 
 // TranslateWithConfig translates a message using the provided LocalizeConfig.
@@ -147,6 +346,7 @@ func NewI18nManager(config I18nConfig) (*I18nManager, error) {
 // the default language if translation is not found in the requested language.
 //
 // The function caches localizers for each language to improve performance on subsequent calls.
+// It is safe for concurrent use by request handlers.
 //
 // Parameters:
 //   - lang: Language code for translation (e.g., "en", "id", "zh")
@@ -164,31 +364,71 @@ func NewI18nManager(config I18nConfig) (*I18nManager, error) {
 //	    },
 //	})
 func (m *I18nManager) TranslateWithConfig(lang string, c *i18n.LocalizeConfig) string {
-	localizer, ok := m.Localizer[lang]
-	if !ok {
-		// Fallback to default language if specific language not found
-		m.Localizer[lang] = i18n.NewLocalizer(m.Bundle, lang)
-		localizer = m.Localizer[lang]
-	}
-	localized, err := localizer.Localize(c)
+	localized, err := m.localize(lang, c)
 	if err != nil {
-		if m.DefaultLanguage != lang {
-			// pakai bahasa default
-			return m.TranslateWithConfig(m.DefaultLanguage, c)
-		} else {
-			// get message id
-			var msgId string
-			if c.MessageID == "" {
-				msgId = c.DefaultMessage.ID
-			} else {
-				msgId = c.MessageID
-			}
-			return fmt.Sprintf("Missing translation for %s: %s", lang, msgId)
+		msgID := messageIDOf(c)
+		// Only report misses that reach the user; TranslateOrError callers have their own fallback
+		if m.config.OnMissing != nil {
+			m.config.OnMissing(lang, msgID)
 		}
+		return fmt.Sprintf("Missing translation for %s: %s", m.DefaultLanguage, msgID)
 	}
 	return localized
 }
 
+// localize translates c into lang, falling back to the default language.
+// It returns ErrMissingTranslation if neither language has the message.
+func (m *I18nManager) localize(lang string, c *i18n.LocalizeConfig) (string, error) {
+	localized, err := m.localizeIn(lang, c)
+	if err != nil && m.DefaultLanguage != lang {
+		// pakai bahasa default
+		localized, err = m.localizeIn(m.DefaultLanguage, c)
+	}
+	if err != nil {
+		return "", fmt.Errorf("%w for %s: %s", ErrMissingTranslation, m.DefaultLanguage, messageIDOf(c))
+	}
+	return localized, nil
+}
+
+// localizeIn translates c into lang only, without fallback
+func (m *I18nManager) localizeIn(lang string, c *i18n.LocalizeConfig) (string, error) {
+	localizer := m.getLocalizer(lang)
+
+	// The bundle is not safe for concurrent writes; AddMessages takes the write lock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return localizer.Localize(c)
+}
+
+// messageIDOf returns the message ID of a LocalizeConfig, falling back to its default message ID
+func messageIDOf(c *i18n.LocalizeConfig) string {
+	if c.MessageID == "" && c.DefaultMessage != nil {
+		return c.DefaultMessage.ID
+	}
+	return c.MessageID
+}
+
+// getLocalizer returns the cached localizer for lang, creating it on first use.
+// Safe for concurrent use by request handlers.
+func (m *I18nManager) getLocalizer(lang string) *i18n.Localizer {
+	m.mu.RLock()
+	localizer, ok := m.Localizer[lang]
+	m.mu.RUnlock()
+	if ok {
+		return localizer
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	// Another goroutine may have created it while we waited for the write lock
+	if localizer, ok := m.Localizer[lang]; ok {
+		return localizer
+	}
+	localizer = i18n.NewLocalizer(m.Bundle, lang)
+	m.Localizer[lang] = localizer
+	return localizer
+}
+
 // Translate is a convenience method for translating messages with optional template data.
 // It wraps TranslateWithConfig with a simpler interface for common use cases.
 //
@@ -198,7 +438,8 @@ func (m *I18nManager) TranslateWithConfig(lang string, c *i18n.LocalizeConfig) s
 //   - template: Optional template data for message interpolation (can be nil)
 //
 // Returns:
-//   - string: Translated message
+//   - string: Translated message, or a "Missing translation for <lang>: <id>" placeholder
+//     if not found (use TranslateOrError to detect missing messages)
 //
 // Example:
 //
@@ -210,6 +451,35 @@ func (m *I18nManager) TranslateWithConfig(lang string, c *i18n.LocalizeConfig) s
 //	    "Email": "user@example.com",
 //	})
 func (m *I18nManager) Translate(lang, messageID string, template interface{}) string {
+	return m.TranslateWithConfig(lang, newLocalizeConfig(messageID, template))
+}
+
+// TranslateOrError translates a message like Translate, but returns ErrMissingTranslation
+// instead of a placeholder string when the message exists in neither the requested nor
+// the default language. Use it when the caller needs its own fallback; misses are not
+// reported to I18nConfig.OnMissing.
+//
+// Parameters:
+//   - lang: Language code for translation (e.g., "en", "id", "zh")
+//   - messageID: The message identifier to translate
+//   - template: Optional template data for message interpolation (can be nil)
+//
+// Returns:
+//   - string: Translated message, or empty string on error
+//   - error: ErrMissingTranslation (wrapped with the language and message ID) if not found
+//
+// Example:
+//
+//	msg, err := manager.TranslateOrError("id", "order_shipped", nil)
+//	if errors.Is(err, i18n.ErrMissingTranslation) {
+//	    msg = "Your order has shipped"
+//	}
+func (m *I18nManager) TranslateOrError(lang, messageID string, template interface{}) (string, error) {
+	return m.localize(lang, newLocalizeConfig(messageID, template))
+}
+
+// newLocalizeConfig builds the LocalizeConfig used by Translate and TranslateOrError
+func newLocalizeConfig(messageID string, template interface{}) *i18n.LocalizeConfig {
 	cfg := &i18n.LocalizeConfig{
 		MessageID:      messageID,
 		DefaultMessage: &i18n.Message{ID: messageID},
@@ -218,10 +488,60 @@ func (m *I18nManager) Translate(lang, messageID string, template interface{}) st
 	if template != nil {
 		cfg.TemplateData = template
 	}
+	return cfg
+}
 
+// TranslatePlural translates a message with plural forms, selecting the CLDR plural form
+// ("one", "other", ...) of the language for count. The count is available in the message
+// template as {{.Count}} (and {{.PluralCount}}), merged into template data given as a map;
+// other template types are passed through unchanged.
+//
+// Locale file format:
+//
+//	"cart_items": {
+//	    "one": "You have {{.Count}} item in your cart",
+//	    "other": "You have {{.Count}} items in your cart"
+//	}
+//
+// Parameters:
+//   - lang: Language code for translation (e.g., "en", "id", "zh")
+//   - messageID: The message identifier to translate
+//   - count: Quantity used to select the plural form
+//   - template: Optional template data for message interpolation (can be nil)
+//
+// Returns:
+//   - string: Translated message, or a "Missing translation" placeholder if not found
+//
+// Example:
+//
+//	msg := manager.TranslatePlural("en", "cart_items", 1, nil) // "You have 1 item in your cart"
+//	msg = manager.TranslatePlural("en", "cart_items", 5, nil)  // "You have 5 items in your cart"
+func (m *I18nManager) TranslatePlural(lang, messageID string, count int, template interface{}) string {
+	cfg := newLocalizeConfig(messageID, withCount(template, count))
+	cfg.PluralCount = count
 	return m.TranslateWithConfig(lang, cfg)
 }
 
+// withCount returns template data with Count and PluralCount set to count.
+// Maps with string keys are copied (the caller's map is not modified); nil becomes a new map;
+// any other value is returned as is.
+func withCount(template interface{}, count int) interface{} {
+	data := map[string]interface{}{}
+	if template != nil {
+		v := reflect.ValueOf(template)
+		if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String {
+			return template
+		}
+		iter := v.MapRange()
+		for iter.Next() {
+			data[iter.Key().String()] = iter.Value().Interface()
+		}
+	}
+	data["Count"] = count
+	data["PluralCount"] = count
+	return data
+}
+
 // Test demonstrates usage of the I18nManager translation methods.
 // This method serves as an example and can be used for testing i18n functionality.
 // It shows both TranslateWithConfig and Translate method usage with template data.
@@ -6,12 +6,18 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
+// supportedLangsKey is the context key under which I18nMiddleware stores config.SupportedLangs,
+// so ProfileLanguageMiddleware can validate against the same list without its own config
+const supportedLangsKey = "i18n_supported_langs"
+
 // I18nMiddleware creates a Fiber middleware handler that extracts the language preference
 // from incoming requests and stores it in the context for use in downstream handlers.
 // The language is extracted from multiple sources with a priority order:
-// 1. Query parameter (?lang=id)
-// 2. Accept-Language HTTP header
-// 3. Default language from config
+// 1. Custom language header (config.LangHeaderName), if configured
+// 2. Query parameter (?lang=id)
+// 3. Language cookie (config.LanguageCookie), if configured
+// 4. Accept-Language HTTP header
+// 5. Default language from config
 //
 // Parameters:
 //   - config: I18nConfig containing default language and supported languages list
@@ -34,15 +40,75 @@ func I18nMiddleware(config I18nConfig) fiber.Handler {
 
 		// Set language in context for use in handlers
 		c.Locals("language", lang)
+		c.Locals(supportedLangsKey, config.SupportedLangs)
 
 		return c.Next()
 	}
 }
 
+// ProfileLanguageMiddleware creates a Fiber middleware handler that applies the authenticated
+// user's saved language preference, overriding the language detected by I18nMiddleware.
+// Register it after both I18nMiddleware and the auth middleware, so the resolver can read
+// the user from the context (e.g., JWT claims).
+//
+// The resolved language is normalized (trimmed, lowercased, region removed: "en-US" -> "en")
+// and only applied if it is supported: listed in config.SupportedLangs when a config is given,
+// otherwise in the SupportedLangs of the I18nMiddleware that handled the request. If the
+// resolver returns an error, an empty string or an unsupported language, the already detected
+// language is kept.
+//
+// Parameters:
+//   - resolver: Function returning the current user's preferred language code
+//   - config: Optional I18nConfig whose supported languages list overrides I18nMiddleware's
+//
+// Returns:
+//   - fiber.Handler: Middleware function to be used with Fiber app
+//
+// Example:
+//
+//	app.Use(I18nMiddleware(config))
+//	api := app.Group("/api", jwtAuth.Middleware())
+//	api.Use(ProfileLanguageMiddleware(func(c *fiber.Ctx) (string, error) {
+//	    claims := c.Locals("claims").(jwt.MapClaims)
+//	    return userRepo.PreferredLanguage(claims["sub"].(string))
+//	}))
+func ProfileLanguageMiddleware(resolver func(c *fiber.Ctx) (string, error), config ...I18nConfig) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		lang, err := resolver(c)
+		if err != nil {
+			return c.Next()
+		}
+
+		supported, _ := c.Locals(supportedLangsKey).([]string)
+		if len(config) > 0 {
+			supported = config[0].SupportedLangs
+		}
+
+		lang = normalizeLanguage(lang)
+		if lang != "" && isSupported(lang, supported) {
+			c.Locals("language", lang)
+		}
+
+		return c.Next()
+	}
+}
+
+// normalizeLanguage converts a stored language value to a primary language code,
+// e.g. " en-US " -> "en", "ID" -> "id", "zh_CN" -> "zh".
+func normalizeLanguage(lang string) string {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	if idx := strings.IndexAny(lang, "-_"); idx > 0 {
+		lang = lang[:idx]
+	}
+	return lang
+}
+
 // extractLanguage extracts the preferred language from an HTTP request following a priority order:
-// 1. Query parameter ?lang=id (highest priority)
-// 2. Accept-Language HTTP header
-// 3. Default language from configuration (fallback)
+// 1. Custom language header from config.LangHeaderName (highest priority, only when set)
+// 2. Query parameter ?lang=id
+// 3. Cookie from config.LanguageCookie (only when set)
+// 4. Accept-Language HTTP header
+// 5. Default language from configuration (fallback)
 //
 // Only languages listed in config.SupportedLangs are accepted. If the requested
 // language is not supported, it falls back to the next source in the priority chain.
@@ -54,14 +120,32 @@ func I18nMiddleware(config I18nConfig) fiber.Handler {
 // Returns:
 //   - string: The selected language code (e.g., "en", "id", "zh")
 func extractLanguage(c *fiber.Ctx, config I18nConfig) string {
-	// 1. Check query parameter
+	// 1. Check custom language header
+	if config.LangHeaderName != "" {
+		if lang := strings.TrimSpace(c.Get(config.LangHeaderName)); lang != "" {
+			if isSupported(lang, config.SupportedLangs) {
+				return lang
+			}
+		}
+	}
+
+	// 2. Check query parameter
 	if lang := c.Query("lang"); lang != "" {
 		if isSupported(lang, config.SupportedLangs) {
 			return lang
 		}
 	}
 
-	// 2. Check Accept-Language header
+	// 3. Check language cookie
+	if config.LanguageCookie != "" {
+		if lang := strings.TrimSpace(c.Cookies(config.LanguageCookie)); lang != "" {
+			if isSupported(lang, config.SupportedLangs) {
+				return lang
+			}
+		}
+	}
+
+	// 4. Check Accept-Language header
 	acceptLang := c.Get("Accept-Language")
 	if acceptLang != "" {
 		// Parse Accept-Language header (simplified)
@@ -73,7 +157,7 @@ func extractLanguage(c *fiber.Ctx, config I18nConfig) string {
 		}
 	}
 
-	// 3. Return default language
+	// 5. Return default language
 	return config.DefaultLanguage.String()
 }
 
@@ -1,7 +1,9 @@
 package i18n
 
 import (
+	"errors"
 	"io"
+	"net/http"
 	"net/http/httptest"
 	"testing"
 
@@ -183,6 +185,148 @@ func TestI18nMiddleware(t *testing.T) {
 			t.Errorf("Expected 'id' from header, got '%s'", string(body))
 		}
 	})
+
+	t.Run("custom_header_wins_over_query_and_accept_language", func(t *testing.T) {
+		headerConfig := config
+		headerConfig.LangHeaderName = "X-App-Language"
+
+		app := fiber.New()
+		app.Use(I18nMiddleware(headerConfig))
+		app.Get("/test", func(c *fiber.Ctx) error {
+			lang := c.Locals("language").(string)
+			return c.SendString(lang)
+		})
+
+		req := httptest.NewRequest("GET", "/test?lang=id", nil)
+		req.Header.Set("X-App-Language", "zh")
+		req.Header.Set("Accept-Language", "en-US,en;q=0.9")
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("Failed to make request: %v", err)
+		}
+
+		body, _ := io.ReadAll(resp.Body)
+		if string(body) != "zh" {
+			t.Errorf("Expected 'zh' from custom header, got '%s'", string(body))
+		}
+	})
+
+	t.Run("unsupported_custom_header_falls_back_to_query", func(t *testing.T) {
+		headerConfig := config
+		headerConfig.LangHeaderName = "X-App-Language"
+
+		app := fiber.New()
+		app.Use(I18nMiddleware(headerConfig))
+		app.Get("/test", func(c *fiber.Ctx) error {
+			lang := c.Locals("language").(string)
+			return c.SendString(lang)
+		})
+
+		req := httptest.NewRequest("GET", "/test?lang=id", nil)
+		req.Header.Set("X-App-Language", "fr")
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("Failed to make request: %v", err)
+		}
+
+		body, _ := io.ReadAll(resp.Body)
+		if string(body) != "id" {
+			t.Errorf("Expected 'id' from query, got '%s'", string(body))
+		}
+	})
+
+	t.Run("custom_header_ignored_when_not_configured", func(t *testing.T) {
+		app := fiber.New()
+		app.Use(I18nMiddleware(config))
+		app.Get("/test", func(c *fiber.Ctx) error {
+			lang := c.Locals("language").(string)
+			return c.SendString(lang)
+		})
+
+		req := httptest.NewRequest("GET", "/test?lang=id", nil)
+		req.Header.Set("X-App-Language", "zh")
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("Failed to make request: %v", err)
+		}
+
+		body, _ := io.ReadAll(resp.Body)
+		if string(body) != "id" {
+			t.Errorf("Expected 'id' from query, got '%s'", string(body))
+		}
+	})
+}
+
+// ============================================================================
+// ProfileLanguageMiddleware Tests
+// ============================================================================
+
+func TestProfileLanguageMiddleware(t *testing.T) {
+	config := I18nConfig{
+		DefaultLanguage: language.English,
+		SupportedLangs:  []string{"en", "id", "zh"},
+	}
+
+	tests := []struct {
+		name        string
+		profileLang string
+		profileErr  error
+		expected    string
+	}{
+		{"profile_overrides_header", "zh", nil, "zh"},
+		{"profile_normalized", " ZH-cn ", nil, "zh"},
+		{"resolver_error_keeps_detected", "zh", errors.New("db unavailable"), "id"},
+		{"unsupported_keeps_detected", "fr", nil, "id"},
+		{"empty_keeps_detected", "", nil, "id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Use(I18nMiddleware(config))
+			app.Use(ProfileLanguageMiddleware(func(c *fiber.Ctx) (string, error) {
+				return tt.profileLang, tt.profileErr
+			}))
+			app.Get("/test", func(c *fiber.Ctx) error {
+				return c.SendString(GetLanguage(c))
+			})
+
+			req := httptest.NewRequest("GET", "/test", nil)
+			req.Header.Set("Accept-Language", "id-ID,id;q=0.9")
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("Failed to make request: %v", err)
+			}
+
+			body, _ := io.ReadAll(resp.Body)
+			if string(body) != tt.expected {
+				t.Errorf("Expected '%s', got '%s'", tt.expected, string(body))
+			}
+		})
+	}
+
+	t.Run("config_overrides_supported_langs", func(t *testing.T) {
+		app := fiber.New()
+		app.Use(I18nMiddleware(config))
+		app.Use(ProfileLanguageMiddleware(func(c *fiber.Ctx) (string, error) {
+			return "zh", nil
+		}, I18nConfig{SupportedLangs: []string{"en", "id"}}))
+		app.Get("/test", func(c *fiber.Ctx) error {
+			return c.SendString(GetLanguage(c))
+		})
+
+		req := httptest.NewRequest("GET", "/test", nil)
+		req.Header.Set("Accept-Language", "id")
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("Failed to make request: %v", err)
+		}
+
+		body, _ := io.ReadAll(resp.Body)
+		if string(body) != "id" {
+			t.Errorf("Expected 'id', got '%s'", string(body))
+		}
+	})
 }
 
 // ============================================================================
@@ -227,6 +371,60 @@ func TestExtractLanguage(t *testing.T) {
 			t.Errorf("Expected default 'en', got '%s'", string(body))
 		}
 	})
+
+	t.Run("language_cookie", func(t *testing.T) {
+		cookieConfig := config
+		cookieConfig.LanguageCookie = "lang"
+
+		app := fiber.New()
+		app.Get("/test", func(c *fiber.Ctx) error {
+			return c.SendString(extractLanguage(c, cookieConfig))
+		})
+
+		tests := []struct {
+			name     string
+			query    string
+			cookie   string
+			expected string
+		}{
+			{"cookie_beats_accept_language", "", "zh", "zh"},
+			{"query_beats_cookie", "?lang=en", "zh", "en"},
+			{"unsupported_cookie_falls_through", "", "fr", "id"},
+			{"no_cookie_uses_accept_language", "", "", "id"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				req := httptest.NewRequest("GET", "/test"+tt.query, nil)
+				req.Header.Set("Accept-Language", "id")
+				if tt.cookie != "" {
+					req.AddCookie(&http.Cookie{Name: "lang", Value: tt.cookie})
+				}
+				resp, _ := app.Test(req)
+				body, _ := io.ReadAll(resp.Body)
+
+				if string(body) != tt.expected {
+					t.Errorf("Expected '%s', got '%s'", tt.expected, string(body))
+				}
+			})
+		}
+	})
+
+	t.Run("cookie_ignored_when_not_configured", func(t *testing.T) {
+		app := fiber.New()
+		app.Get("/test", func(c *fiber.Ctx) error {
+			return c.SendString(extractLanguage(c, config))
+		})
+
+		req := httptest.NewRequest("GET", "/test", nil)
+		req.AddCookie(&http.Cookie{Name: "lang", Value: "zh"})
+		resp, _ := app.Test(req)
+		body, _ := io.ReadAll(resp.Body)
+
+		if string(body) != "en" {
+			t.Errorf("Expected default 'en', got '%s'", string(body))
+		}
+	})
 }
 
 // ============================================================================
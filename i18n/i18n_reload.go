@@ -0,0 +1,135 @@
+package i18n
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+)
+
+// Reload rebuilds the bundle from the locale files of the original I18nConfig (read from the
+// same fs.FS when created with NewI18nManagerFromFS) and clears the cached localizers, so
+// updated translations are picked up without a restart. Messages added with AddMessages are kept.
+// If any file fails to load, the current translations are kept and the error is returned.
+//
+// Returns:
+//   - error: Error if a locale file cannot be read or parsed
+//
+// Example:
+//
+//	app.Post("/admin/i18n/reload", func(c *fiber.Ctx) error {
+//	    if err := manager.Reload(); err != nil {
+//	        return response.Error(c, fiber.StatusInternalServerError, err.Error())
+//	    }
+//	    return response.Success(c, "Translations reloaded", nil)
+//	})
+func (m *I18nManager) Reload() error {
+	bundle, err := m.loadBundle()
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	// Keep messages added at runtime on top of the reloaded files
+	for tag, byID := range m.added {
+		messages := make([]*i18n.Message, 0, len(byID))
+		for _, msg := range byID {
+			messages = append(messages, msg)
+		}
+		if err := bundle.AddMessages(tag, messages...); err != nil {
+			return err
+		}
+	}
+	m.Bundle = bundle
+	m.Localizer = make(map[string]*i18n.Localizer)
+	return nil
+}
+
+// WatchLocales polls the locale files every interval and calls Reload when any of them
+// is modified. Reload errors are logged and the previous translations stay active.
+// Call the returned function to stop watching; it returns once the watcher has exited.
+//
+// Parameters:
+//   - interval: How often to check the files' modification times (default: 5 seconds)
+//
+// Returns:
+//   - func(): Stops the watcher; safe to call more than once
+//
+// Example:
+//
+//	stop := manager.WatchLocales(10 * time.Second)
+//	defer stop()
+func (m *I18nManager) WatchLocales(interval time.Duration) (stop func()) {
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	files := localeFiles(m.config)
+	last := m.localeModTimes(files)
+	done := make(chan struct{})
+	exited := make(chan struct{})
+
+	go func() {
+		defer close(exited)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				current := m.localeModTimes(files)
+				if !modTimesChanged(last, current) {
+					continue
+				}
+				if err := m.Reload(); err != nil {
+					log.Errorf("i18n: %v", err)
+				}
+				last = current
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(done) })
+		<-exited
+	}
+}
+
+// localeModTimes returns the modification time of each existing file
+func (m *I18nManager) localeModTimes(files []localeFile) map[string]time.Time {
+	times := make(map[string]time.Time, len(files))
+	for _, file := range files {
+		var info fs.FileInfo
+		var err error
+		if m.fsys != nil {
+			info, err = fs.Stat(m.fsys, path.Clean(file.path))
+		} else {
+			info, err = os.Stat(file.path)
+		}
+		if err == nil {
+			times[file.path] = info.ModTime()
+		}
+	}
+	return times
+}
+
+// modTimesChanged reports whether any file was modified, created or removed
+func modTimesChanged(last, current map[string]time.Time) bool {
+	if len(last) != len(current) {
+		return true
+	}
+	for file, t := range current {
+		if !t.Equal(last[file]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,160 @@
+package i18n
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+func writeLocale(t *testing.T, dir, lang, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, lang+".json"), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func newReloadManager(t *testing.T) (*I18nManager, string) {
+	t.Helper()
+	dir := t.TempDir()
+	writeLocale(t, dir, "en", `{"greeting": "Hello"}`)
+	writeLocale(t, dir, "id", `{"greeting": "Halo"}`)
+
+	manager, err := NewI18nManager(I18nConfig{
+		DefaultLanguage: language.English,
+		SupportedLangs:  []string{"en", "id"},
+		LocalesPath:     dir,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create I18nManager: %v", err)
+	}
+	return manager, dir
+}
+
+func TestReload(t *testing.T) {
+	manager, dir := newReloadManager(t)
+
+	if msg := manager.Translate("id", "greeting", nil); msg != "Halo" {
+		t.Fatalf("Expected 'Halo', got %q", msg)
+	}
+
+	writeLocale(t, dir, "id", `{"greeting": "Hai", "farewell": "Sampai jumpa"}`)
+	if err := manager.Reload(); err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+
+	if len(manager.Localizer) != 0 {
+		t.Errorf("Expected localizer cache to be cleared, got %d entries", len(manager.Localizer))
+	}
+	if msg := manager.Translate("id", "greeting", nil); msg != "Hai" {
+		t.Errorf("Expected updated translation 'Hai', got %q", msg)
+	}
+	if msg := manager.Translate("id", "farewell", nil); msg != "Sampai jumpa" {
+		t.Errorf("Expected new key to be available, got %q", msg)
+	}
+
+	// A broken file keeps the current translations
+	writeLocale(t, dir, "id", `{"greeting": `)
+	if err := manager.Reload(); err == nil {
+		t.Error("Expected Reload to fail on malformed JSON")
+	}
+	if msg := manager.Translate("id", "greeting", nil); msg != "Hai" {
+		t.Errorf("Expected previous translation to stay active, got %q", msg)
+	}
+}
+
+func TestWatchLocales(t *testing.T) {
+	manager, dir := newReloadManager(t)
+
+	stop := manager.WatchLocales(10 * time.Millisecond)
+	defer stop()
+
+	writeLocale(t, dir, "en", `{"greeting": "Hi there"}`)
+	// Make sure the change is visible even on filesystems with coarse timestamps
+	future := time.Now().Add(time.Second)
+	if err := os.Chtimes(filepath.Join(dir, "en.json"), future, future); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for manager.Translate("en", "greeting", nil) != "Hi there" {
+		if time.Now().After(deadline) {
+			t.Fatal("Expected watcher to reload the changed locale file")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	stop()
+	stop() // stopping twice is safe
+}
+
+func TestAddMessages(t *testing.T) {
+	manager, _ := newReloadManager(t)
+
+	// Populate the localizer cache
+	manager.Translate("id", "greeting", nil)
+
+	err := manager.AddMessages("id", map[string]string{
+		"greeting":    "Hai",
+		"promo_title": "Diskon {{.Percent}}%",
+	})
+	if err != nil {
+		t.Fatalf("AddMessages failed: %v", err)
+	}
+
+	if _, ok := manager.Localizer["id"]; ok {
+		t.Error("Expected cached localizer for id to be cleared")
+	}
+	if msg := manager.Translate("id", "greeting", nil); msg != "Hai" {
+		t.Errorf("Expected added message to override file message, got %q", msg)
+	}
+	if msg := manager.Translate("id", "promo_title", map[string]int{"Percent": 20}); msg != "Diskon 20%" {
+		t.Errorf("Expected templated added message, got %q", msg)
+	}
+	if msg := manager.Translate("en", "greeting", nil); msg != "Hello" {
+		t.Errorf("Expected other languages to be unaffected, got %q", msg)
+	}
+
+	// A language without locale files
+	if err := manager.AddMessages("fr", map[string]string{"greeting": "Bonjour"}); err != nil {
+		t.Fatalf("AddMessages failed: %v", err)
+	}
+	if !manager.HasLanguage("fr") || manager.Translate("fr", "greeting", nil) != "Bonjour" {
+		t.Error("Expected added language to be available")
+	}
+
+	// Added messages survive a reload
+	if err := manager.Reload(); err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+	if msg := manager.Translate("id", "greeting", nil); msg != "Hai" {
+		t.Errorf("Expected added message to survive Reload, got %q", msg)
+	}
+
+	if err := manager.AddMessages("not a language!", map[string]string{"x": "y"}); err == nil {
+		t.Error("Expected error for invalid language")
+	}
+}
+
+func TestAddMessagesConcurrent(t *testing.T) {
+	manager, _ := newReloadManager(t)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			manager.Translate("id", "greeting", nil)
+		}()
+		go func() {
+			defer wg.Done()
+			if err := manager.AddMessages("id", map[string]string{"dynamic": "value"}); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+}
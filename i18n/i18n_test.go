@@ -1,8 +1,12 @@
 package i18n
 
 import (
+	"errors"
+	"os"
 	"strings"
+	"sync"
 	"testing"
+	"testing/fstest"
 
 	"github.com/nicksnyder/go-i18n/v2/i18n"
 	"golang.org/x/text/language"
@@ -57,21 +61,38 @@ func TestNewI18nManager(t *testing.T) {
 
 	t.Run("default_locales_path", func(t *testing.T) {
 		// Test with empty LocalesPath - should default to "locales"
-		// But since we don't have locales/ in root, this should panic
+		// But since we don't have locales/ in the package directory, this should fail
 		config := I18nConfig{
 			DefaultLanguage: language.English,
 			SupportedLangs:  []string{"en", "id"},
 			LocalesPath:     "",
 		}
 
-		// We expect this to panic since locales/ doesn't exist in project root
-		defer func() {
-			if r := recover(); r == nil {
-				t.Error("Expected panic when locale files not found")
-			}
-		}()
+		manager, err := NewI18nManager(config)
+		if err == nil {
+			t.Fatal("Expected error when locale files not found")
+		}
+		if manager != nil {
+			t.Error("Expected nil manager on error")
+		}
+		if !strings.Contains(err.Error(), `"en"`) || !strings.Contains(err.Error(), "locales/en.json") {
+			t.Errorf("Expected error to name the language and file, got %v", err)
+		}
+	})
+
+	t.Run("malformed_locale_file", func(t *testing.T) {
+		dir := t.TempDir()
+		writeLocale(t, dir, "en", `{"greeting": "Hello"}`)
+		writeLocale(t, dir, "id", `{"greeting": `)
 
-		NewI18nManager(config)
+		_, err := NewI18nManager(I18nConfig{
+			DefaultLanguage: language.English,
+			SupportedLangs:  []string{"en", "id"},
+			LocalesPath:     dir,
+		})
+		if err == nil || !strings.Contains(err.Error(), `"id"`) {
+			t.Errorf("Expected error naming the id locale file, got %v", err)
+		}
 	})
 
 	t.Run("indonesian_default_language", func(t *testing.T) {
@@ -92,6 +113,67 @@ func TestNewI18nManager(t *testing.T) {
 	})
 }
 
+func TestNewI18nManagerFromFS(t *testing.T) {
+	t.Run("flat_structure", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"locales/en.json": {Data: []byte(`{"greeting": "Hello"}`)},
+			"locales/id.json": {Data: []byte(`{"greeting": "Halo"}`)},
+		}
+
+		manager, err := NewI18nManagerFromFS(fsys, I18nConfig{
+			DefaultLanguage: language.English,
+			SupportedLangs:  []string{"en", "id"},
+		})
+		if err != nil {
+			t.Fatalf("Failed to create I18nManager from FS: %v", err)
+		}
+		if msg := manager.Translate("id", "greeting", nil); msg != "Halo" {
+			t.Errorf("Expected 'Halo', got %q", msg)
+		}
+	})
+
+	t.Run("dot_prefixed_locales_path", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"i18n/en.json": {Data: []byte(`{"profile": "Profile"}`)},
+		}
+
+		manager, err := NewI18nManagerFromFS(fsys, I18nConfig{
+			DefaultLanguage: language.English,
+			SupportedLangs:  []string{"en"},
+			LocalesPath:     "./i18n",
+		})
+		if err != nil {
+			t.Fatalf("Failed to create I18nManager from FS: %v", err)
+		}
+		if msg := manager.Translate("en", "profile", nil); msg != "Profile" {
+			t.Errorf("Expected 'Profile', got %q", msg)
+		}
+	})
+
+	t.Run("os_dir_fs", func(t *testing.T) {
+		manager, err := NewI18nManagerFromFS(os.DirFS(".."), I18nConfig{
+			DefaultLanguage: language.English,
+			SupportedLangs:  []string{"en", "id", "zh"},
+		})
+		if err != nil {
+			t.Fatalf("Failed to create I18nManager from FS: %v", err)
+		}
+		if !manager.HasLanguage("zh") {
+			t.Error("Expected zh to be loaded")
+		}
+	})
+
+	t.Run("missing_file_returns_error", func(t *testing.T) {
+		_, err := NewI18nManagerFromFS(fstest.MapFS{}, I18nConfig{
+			DefaultLanguage: language.English,
+			SupportedLangs:  []string{"en"},
+		})
+		if err == nil {
+			t.Error("Expected error for missing locale file")
+		}
+	})
+}
+
 // ============================================================================
 // Translation Tests
 // ============================================================================
@@ -231,6 +313,128 @@ func TestTranslateFallback(t *testing.T) {
 	})
 }
 
+func TestTranslatePlural(t *testing.T) {
+	manager, err := NewI18nManager(I18nConfig{
+		DefaultLanguage: language.English,
+		SupportedLangs:  []string{"en", "id"},
+		LocalesPath:     "../locales",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create I18nManager: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		lang     string
+		count    int
+		expected string
+	}{
+		{"en_one", "en", 1, "You have 1 item in your cart"},
+		{"en_other", "en", 5, "You have 5 items in your cart"},
+		{"en_zero", "en", 0, "You have 0 items in your cart"},
+		// Indonesian has a single plural form
+		{"id_one", "id", 1, "Anda memiliki 1 barang di keranjang"},
+		{"id_other", "id", 5, "Anda memiliki 5 barang di keranjang"},
+		{"fallback_to_default", "fr", 2, "You have 2 items in your cart"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := manager.TranslatePlural(tt.lang, "cart_items", tt.count, nil); got != tt.expected {
+				t.Errorf("Expected '%s', got '%s'", tt.expected, got)
+			}
+		})
+	}
+
+	t.Run("merges_template_data", func(t *testing.T) {
+		data := map[string]string{"Name": "John"}
+		got := manager.TranslatePlural("en", "hello_name", 3, data)
+		if got != "Hello, John!" {
+			t.Errorf("Expected template data to be kept, got '%s'", got)
+		}
+		if _, ok := data["Count"]; ok {
+			t.Error("Expected caller's template map not to be modified")
+		}
+	})
+
+	t.Run("missing_key", func(t *testing.T) {
+		got := manager.TranslatePlural("en", "nonexistent_plural", 2, nil)
+		if !strings.Contains(got, "Missing translation") {
+			t.Errorf("Expected missing translation placeholder, got '%s'", got)
+		}
+	})
+}
+
+func TestOnMissingHook(t *testing.T) {
+	type miss struct{ lang, messageID string }
+	var misses []miss
+
+	manager, err := NewI18nManager(I18nConfig{
+		DefaultLanguage: language.English,
+		SupportedLangs:  []string{"en", "id"},
+		LocalesPath:     "../locales",
+		OnMissing: func(lang, messageID string) {
+			misses = append(misses, miss{lang, messageID})
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create I18nManager: %v", err)
+	}
+
+	manager.Translate("id", "welcome", nil)             // found
+	manager.Translate("fr", "welcome", nil)             // found via default language
+	manager.Translate("id", "nonexistent_key_xyz", nil) // missing everywhere
+	manager.TranslatePlural("en", "another_missing_key", 2, nil)
+	manager.TranslateOrError("en", "probed_key", nil) // caller handles the miss, not reported
+
+	expected := []miss{{"id", "nonexistent_key_xyz"}, {"en", "another_missing_key"}}
+	if len(misses) != len(expected) {
+		t.Fatalf("Expected %d hook calls, got %v", len(expected), misses)
+	}
+	for i, want := range expected {
+		if misses[i] != want {
+			t.Errorf("Hook call %d: expected %v, got %v", i, want, misses[i])
+		}
+	}
+}
+
+func TestTranslateOrError(t *testing.T) {
+	manager, err := NewI18nManager(I18nConfig{
+		DefaultLanguage: language.English,
+		SupportedLangs:  []string{"en", "id"},
+		LocalesPath:     "../locales",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create I18nManager: %v", err)
+	}
+
+	t.Run("existing_key", func(t *testing.T) {
+		result, err := manager.TranslateOrError("id", "selamat_pagi", nil)
+		if err != nil || result != "Selamat pagi" {
+			t.Errorf("Expected 'Selamat pagi', got %q, %v", result, err)
+		}
+	})
+
+	t.Run("fallback_to_default_language", func(t *testing.T) {
+		result, err := manager.TranslateOrError("fr", "welcome", nil)
+		if err != nil || result != manager.Translate("en", "welcome", nil) {
+			t.Errorf("Expected English fallback, got %q, %v", result, err)
+		}
+	})
+
+	t.Run("missing_key", func(t *testing.T) {
+		result, err := manager.TranslateOrError("id", "nonexistent_key_xyz", nil)
+		if !errors.Is(err, ErrMissingTranslation) {
+			t.Errorf("Expected ErrMissingTranslation, got %v", err)
+		}
+		if result != "" {
+			t.Errorf("Expected empty result, got %q", result)
+		}
+		if !strings.Contains(err.Error(), "nonexistent_key_xyz") {
+			t.Errorf("Expected error to name the message ID, got %v", err)
+		}
+	})
+}
+
 // ============================================================================
 // TranslateWithConfig Tests
 // ============================================================================
@@ -371,3 +575,69 @@ func TestLocalizerCaching(t *testing.T) {
 		}
 	})
 }
+
+func TestTranslateConcurrent(t *testing.T) {
+	manager, err := NewI18nManager(I18nConfig{
+		DefaultLanguage: language.English,
+		SupportedLangs:  []string{"en", "id", "zh"},
+		LocalesPath:     "../locales",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create I18nManager: %v", err)
+	}
+
+	langs := []string{"en", "id", "zh", "fr"}
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			lang := langs[i%len(langs)]
+			if msg := manager.Translate(lang, "welcome", nil); msg == "" {
+				t.Errorf("Expected translation for %s", lang)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if len(manager.Localizer) != len(langs) {
+		t.Errorf("Expected %d cached localizers, got %d", len(langs), len(manager.Localizer))
+	}
+}
+
+// ============================================================================
+// Supported Languages Tests
+// ============================================================================
+
+func TestSupportedLanguages(t *testing.T) {
+	manager, err := NewI18nManager(I18nConfig{
+		DefaultLanguage: language.English,
+		SupportedLangs:  []string{"en", "id"},
+		LocalesPath:     "../locales",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create I18nManager: %v", err)
+	}
+
+	langs := manager.SupportedLanguages()
+	if strings.Join(langs, ",") != "en,id" {
+		t.Errorf("Expected [en id], got %v", langs)
+	}
+
+	tests := []struct {
+		lang string
+		want bool
+	}{
+		{"en", true},
+		{"id", true},
+		{" ID ", true},
+		{"zh", false}, // locale file exists but was not loaded
+		{"fr", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := manager.HasLanguage(tt.lang); got != tt.want {
+			t.Errorf("HasLanguage(%q) = %v, want %v", tt.lang, got, tt.want)
+		}
+	}
+}
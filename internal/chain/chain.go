@@ -0,0 +1,108 @@
+// Package chain runs a list of Fiber handlers as one step of a parent route.
+// It is shared by helpers.Chain and auth.ChainAuth.
+package chain
+
+import (
+	"sync"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/valyala/fasthttp"
+)
+
+// localKey is the type of the context locals key used by a Chain.
+// It has a non-zero size so that every allocation yields a distinct pointer.
+type localKey struct{ _ byte }
+
+// runState is the state of one Run call, stored in the request locals
+type runState struct {
+	next    func() error
+	reached bool
+	err     error
+}
+
+// routeKey identifies the parent app and route a nested handler was built for
+type routeKey struct {
+	app  *fiber.App
+	path string
+}
+
+// Chain runs handlers in order on a nested Fiber app sharing the parent request.
+// Handlers continue with c.Next() as usual; once the last one calls c.Next(), the
+// function passed to Run is called.
+type Chain struct {
+	handlers []fiber.Handler
+
+	stateKey *localKey
+
+	// apps caches one nested handler per parent app and route (routeKey -> fasthttp.RequestHandler)
+	apps sync.Map
+}
+
+// New creates a Chain running the given handlers in order
+func New(handlers ...fiber.Handler) *Chain {
+	return &Chain{
+		handlers: handlers,
+		stateKey: &localKey{},
+	}
+}
+
+// Run runs the handlers on c's request and response. When the last handler calls
+// c.Next(), next is called (if not nil) and reached is true. The error is the one
+// returned inside the chain or by next; it is not passed to any error handler.
+//
+// The nested app is built from the parent app's config and registers the handlers on
+// the parent route's path, so c.Params() and c.Route().Path match the parent route
+// inside the chain.
+func (ch *Chain) Run(c *fiber.Ctx, next func() error) (reached bool, err error) {
+	handler := ch.handler(c)
+
+	// Keep the state of an outer Run of the same chain, e.g. when it is used twice on a route
+	prev := c.Locals(ch.stateKey)
+	state := &runState{next: next}
+	c.Locals(ch.stateKey, state)
+	handler(c.Context())
+
+	if prev != nil {
+		c.Locals(ch.stateKey, prev)
+	} else {
+		c.Context().RemoveUserValue(ch.stateKey)
+	}
+	return state.reached, state.err
+}
+
+// handler returns the nested handler for c's app and route, building it on first use
+func (ch *Chain) handler(c *fiber.Ctx) fasthttp.RequestHandler {
+	key := routeKey{app: c.App(), path: c.Route().Path}
+	if h, ok := ch.apps.Load(key); ok {
+		return h.(fasthttp.RequestHandler)
+	}
+
+	config := key.app.Config()
+	config.ETag = false
+	// Hand errors back to Run instead of writing a response here
+	config.ErrorHandler = func(c *fiber.Ctx, err error) error {
+		c.Locals(ch.stateKey).(*runState).err = err
+		return nil
+	}
+
+	inner := fiber.New(config)
+	args := make([]interface{}, 0, len(ch.handlers)+2)
+	args = append(args, key.path)
+	for _, h := range ch.handlers {
+		args = append(args, h)
+	}
+	args = append(args, fiber.Handler(func(c *fiber.Ctx) error {
+		state := c.Locals(ch.stateKey).(*runState)
+		state.reached = true
+		if state.next != nil {
+			return state.next()
+		}
+		return nil
+	}))
+	// The parent route already matched the request, so a prefix match on its path is
+	// enough to parse the same params for both middlewares and endpoints
+	inner.Use(args...)
+
+	h, _ := ch.apps.LoadOrStore(key, inner.Handler())
+	return h.(fasthttp.RequestHandler)
+}
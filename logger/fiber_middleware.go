@@ -0,0 +1,144 @@
+package logger
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// AccessLogFormat selects the line format written by FiberMiddleware.
+type AccessLogFormat string
+
+const (
+	// FormatDefault writes a structured line through Infof:
+	// [2006-01-02 15:04:05] INFO: 200 GET /users 1.2ms ip=127.0.0.1 bytes=512
+	FormatDefault AccessLogFormat = "default"
+
+	// FormatCombined writes the Apache combined log format:
+	// 127.0.0.1 - - [02/Jan/2006:15:04:05 -0700] "GET /users HTTP/1.1" 200 512 "referer" "user-agent"
+	FormatCombined AccessLogFormat = "combined"
+)
+
+// combinedTimeFormat is the timestamp layout used by the Apache combined log format
+const combinedTimeFormat = "02/Jan/2006:15:04:05 -0700"
+
+// FiberLoggerConfig defines the configuration for FiberMiddleware.
+//
+// Fields:
+//   - Format: Access log line format (default: FormatDefault)
+//   - SlowRequestThreshold: Requests taking longer than this also emit a WARN line (0 disables)
+//   - LargeResponseThreshold: Responses larger than this many bytes also emit a WARN line (0 disables)
+type FiberLoggerConfig struct {
+	Format                 AccessLogFormat
+	SlowRequestThreshold   time.Duration
+	LargeResponseThreshold int
+}
+
+// FiberMiddleware creates a Fiber middleware that writes one access log line per request.
+// Errors returned by downstream handlers are passed to the app's ErrorHandler first,
+// so the logged status matches the response sent to the client.
+// The access line is suppressed when ShowOutput is false; the threshold warnings follow
+// the level like Warnf.
+//
+// Parameters:
+//   - config: Optional FiberLoggerConfig (defaults to FormatDefault)
+//
+// Returns:
+//   - fiber.Handler: Middleware function to be used with Fiber app
+//
+// Example:
+//
+//	app.Use(logger.FiberMiddleware())
+//
+//	// Apache combined format for existing log parsers
+//	app.Use(logger.FiberMiddleware(logger.FiberLoggerConfig{
+//	    Format: logger.FormatCombined,
+//	}))
+//
+//	// Warn about slow requests and large responses
+//	app.Use(logger.FiberMiddleware(logger.FiberLoggerConfig{
+//	    SlowRequestThreshold:   500 * time.Millisecond,
+//	    LargeResponseThreshold: 1 << 20,
+//	}))
+func FiberMiddleware(config ...FiberLoggerConfig) fiber.Handler {
+	cfg := FiberLoggerConfig{}
+	if len(config) > 0 {
+		cfg = config[0]
+	}
+	if cfg.Format == "" {
+		cfg.Format = FormatDefault
+	}
+
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+
+		if err := c.Next(); err != nil {
+			if herr := c.App().ErrorHandler(c, err); herr != nil {
+				_ = c.SendStatus(fiber.StatusInternalServerError)
+			}
+		}
+
+		latency := time.Since(start)
+		size := len(c.Response().Body())
+
+		// Only the access line follows ShowOutput; the threshold warnings below
+		// go through Warnf's own level check so they still show at LevelWarn
+		if showOutput() {
+			switch cfg.Format {
+			case FormatCombined:
+				write("%s\n", combinedLogLine(c, start))
+			default:
+				Infof("%d %s %s %s ip=%s bytes=%d",
+					c.Response().StatusCode(),
+					c.Method(),
+					c.OriginalURL(),
+					latency,
+					c.IP(),
+					size,
+				)
+			}
+		}
+
+		if cfg.SlowRequestThreshold > 0 && latency > cfg.SlowRequestThreshold {
+			Warnf("slow request: %s %s took %s (threshold %s)",
+				c.Method(), c.OriginalURL(), latency, cfg.SlowRequestThreshold)
+		}
+		if cfg.LargeResponseThreshold > 0 && size > cfg.LargeResponseThreshold {
+			Warnf("large response: %s %s returned %d bytes (threshold %d)",
+				c.Method(), c.OriginalURL(), size, cfg.LargeResponseThreshold)
+		}
+
+		return nil
+	}
+}
+
+// combinedLogLine formats the request in the Apache combined log format.
+// Missing referer and user agent values are written as "-".
+func combinedLogLine(c *fiber.Ctx, start time.Time) string {
+	return fmt.Sprintf(`%s - - [%s] "%s %s %s" %d %d "%s" "%s"`,
+		c.IP(),
+		start.Format(combinedTimeFormat),
+		c.Method(),
+		escapeQuoted(c.OriginalURL()),
+		string(c.Request().Header.Protocol()),
+		c.Response().StatusCode(),
+		len(c.Response().Body()),
+		escapeQuoted(orDash(c.Get(fiber.HeaderReferer))),
+		escapeQuoted(orDash(c.Get(fiber.HeaderUserAgent))),
+	)
+}
+
+// escapeQuoted escapes double quotes so a value cannot break out of a quoted log field.
+func escapeQuoted(s string) string {
+	return strings.ReplaceAll(s, `"`, `\"`)
+}
+
+// orDash returns "-" for empty values, as used by the common/combined log formats.
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,173 @@
+package logger
+
+import (
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestFiberMiddleware(t *testing.T) {
+	t.Run("combined_format", func(t *testing.T) {
+		ShowOutput = true
+		app := fiber.New()
+		app.Use(FiberMiddleware(FiberLoggerConfig{Format: FormatCombined}))
+		app.Get("/users", func(c *fiber.Ctx) error {
+			return c.SendString("hello")
+		})
+
+		output := captureOutput(func() {
+			req := httptest.NewRequest("GET", "/users?page=2", nil)
+			req.Header.Set("Referer", "https://example.com/")
+			req.Header.Set("User-Agent", "test-agent/1.0")
+			app.Test(req)
+		})
+
+		pattern := regexp.MustCompile(`^0\.0\.0\.0 - - \[\d{2}/\w{3}/\d{4}:\d{2}:\d{2}:\d{2} [+-]\d{4}\] "GET /users\?page=2 HTTP/1\.1" 200 5 "https://example\.com/" "test-agent/1\.0"\n$`)
+		if !pattern.MatchString(output) {
+			t.Errorf("Expected combined log line, got: %q", output)
+		}
+	})
+
+	t.Run("combined_format_missing_headers", func(t *testing.T) {
+		ShowOutput = true
+		app := fiber.New()
+		app.Use(FiberMiddleware(FiberLoggerConfig{Format: FormatCombined}))
+		app.Get("/missing", func(c *fiber.Ctx) error {
+			return fiber.ErrNotFound
+		})
+
+		output := captureOutput(func() {
+			req := httptest.NewRequest("GET", "/missing", nil)
+			req.Header.Del("User-Agent")
+			app.Test(req)
+		})
+
+		if !strings.Contains(output, `"GET /missing HTTP/1.1" 404`) {
+			t.Errorf("Expected request line and error status, got: %q", output)
+		}
+		if !strings.HasSuffix(output, `"-" "-"`+"\n") {
+			t.Errorf("Expected dashes for missing referer and user agent, got: %q", output)
+		}
+	})
+
+	t.Run("default_format", func(t *testing.T) {
+		ShowOutput = true
+		app := fiber.New()
+		app.Use(FiberMiddleware())
+		app.Get("/users", func(c *fiber.Ctx) error {
+			return c.SendString("hello")
+		})
+
+		output := captureOutput(func() {
+			app.Test(httptest.NewRequest("GET", "/users", nil))
+		})
+
+		if !strings.Contains(output, "INFO: 200 GET /users") {
+			t.Errorf("Expected structured access line, got: %q", output)
+		}
+		if !strings.Contains(output, "bytes=5") {
+			t.Errorf("Expected response size, got: %q", output)
+		}
+	})
+
+	t.Run("slow_request_warning", func(t *testing.T) {
+		ShowOutput = true
+		app := fiber.New()
+		app.Use(FiberMiddleware(FiberLoggerConfig{SlowRequestThreshold: 10 * time.Millisecond}))
+		app.Get("/slow", func(c *fiber.Ctx) error {
+			time.Sleep(20 * time.Millisecond)
+			return c.SendString("done")
+		})
+		app.Get("/fast", func(c *fiber.Ctx) error {
+			return c.SendString("done")
+		})
+
+		output := captureOutput(func() {
+			app.Test(httptest.NewRequest("GET", "/slow", nil))
+		})
+		if !strings.Contains(output, "INFO: 200 GET /slow") {
+			t.Errorf("Expected access line alongside warning, got: %q", output)
+		}
+		if !strings.Contains(output, "WARN: slow request: GET /slow took") {
+			t.Errorf("Expected slow request warning, got: %q", output)
+		}
+
+		output = captureOutput(func() {
+			app.Test(httptest.NewRequest("GET", "/fast", nil))
+		})
+		if strings.Contains(output, "WARN") {
+			t.Errorf("Expected no warning for fast request, got: %q", output)
+		}
+	})
+
+	t.Run("slow_request_warning_at_warn_level", func(t *testing.T) {
+		SetLevel(LevelWarn)
+		defer SetLevel(LevelDebug)
+
+		app := fiber.New()
+		app.Use(FiberMiddleware(FiberLoggerConfig{SlowRequestThreshold: 10 * time.Millisecond}))
+		app.Get("/slow", func(c *fiber.Ctx) error {
+			time.Sleep(20 * time.Millisecond)
+			return c.SendString("done")
+		})
+
+		output := captureOutput(func() {
+			app.Test(httptest.NewRequest("GET", "/slow", nil))
+		})
+		if !strings.Contains(output, "WARN: slow request: GET /slow took") {
+			t.Errorf("Expected slow request warning at LevelWarn, got: %q", output)
+		}
+		if strings.Contains(output, "INFO:") {
+			t.Errorf("Expected no access line at LevelWarn, got: %q", output)
+		}
+	})
+
+	t.Run("large_response_warning", func(t *testing.T) {
+		ShowOutput = true
+		app := fiber.New()
+		app.Use(FiberMiddleware(FiberLoggerConfig{LargeResponseThreshold: 100}))
+		app.Get("/export", func(c *fiber.Ctx) error {
+			return c.SendString(strings.Repeat("x", 150))
+		})
+		app.Get("/small", func(c *fiber.Ctx) error {
+			return c.SendString("ok")
+		})
+
+		output := captureOutput(func() {
+			app.Test(httptest.NewRequest("GET", "/export", nil))
+		})
+		if !strings.Contains(output, "WARN: large response: GET /export returned 150 bytes (threshold 100)") {
+			t.Errorf("Expected large response warning, got: %q", output)
+		}
+
+		output = captureOutput(func() {
+			app.Test(httptest.NewRequest("GET", "/small", nil))
+		})
+		if strings.Contains(output, "WARN") {
+			t.Errorf("Expected no warning for small response, got: %q", output)
+		}
+	})
+
+	t.Run("suppressed_when_output_disabled", func(t *testing.T) {
+		ShowOutput = false
+		defer func() { ShowOutput = true }()
+
+		app := fiber.New()
+		app.Use(FiberMiddleware())
+		app.Get("/users", func(c *fiber.Ctx) error {
+			return c.SendString("hello")
+		})
+
+		output := captureOutput(func() {
+			app.Test(httptest.NewRequest("GET", "/users", nil))
+		})
+
+		if output != "" {
+			t.Errorf("Expected no output, got: %q", output)
+		}
+	})
+}
@@ -0,0 +1,104 @@
+package logger
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Level is the minimum severity written by the leveled logging functions.
+type Level int
+
+const (
+	// LevelDebug writes everything, including Debugf (default)
+	LevelDebug Level = iota
+	// LevelInfo writes Infof, Warnf and Errorf
+	LevelInfo
+	// LevelWarn writes Warnf and Errorf
+	LevelWarn
+	// LevelError writes Errorf only
+	LevelError
+)
+
+// level is the configured threshold, see SetLevel. It is guarded by settingsMu.
+var level = LevelDebug
+
+// String returns the level name as written in log lines (e.g., "WARN").
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "DEBUG"
+	case LevelInfo:
+		return "INFO"
+	case LevelWarn:
+		return "WARN"
+	case LevelError:
+		return "ERROR"
+	default:
+		return fmt.Sprintf("Level(%d)", int(l))
+	}
+}
+
+// ParseLevel converts a level name such as "warn" or "ERROR" to a Level.
+// Matching is case-insensitive and "WARNING" is accepted as an alias of "WARN".
+//
+// Parameters:
+//   - s: Level name
+//
+// Returns:
+//   - Level: The parsed level
+//   - error: Error if s is not a known level name
+//
+// Example:
+//
+//	lvl, err := logger.ParseLevel(os.Getenv("LOG_LEVEL"))
+//	if err == nil {
+//	    logger.SetLevel(lvl)
+//	}
+func ParseLevel(s string) (Level, error) {
+	switch strings.ToUpper(strings.TrimSpace(s)) {
+	case "DEBUG":
+		return LevelDebug, nil
+	case "INFO":
+		return LevelInfo, nil
+	case "WARN", "WARNING":
+		return LevelWarn, nil
+	case "ERROR":
+		return LevelError, nil
+	default:
+		return LevelDebug, fmt.Errorf("logger: unknown level %q", s)
+	}
+}
+
+// SetLevel sets the minimum level written by Debugf, Infof, Warnf and Errorf.
+// The legacy flags are derived from it for backward compatibility:
+// ShowDebug is true only at LevelDebug, and ShowOutput (Printf, PrintHex and
+// FiberMiddleware) is true at LevelInfo and below.
+// It is safe to call while other goroutines are logging.
+//
+// Parameters:
+//   - l: Minimum level to write
+//
+// Example:
+//
+//	// Production: warnings and errors only
+//	logger.SetLevel(logger.LevelWarn)
+func SetLevel(l Level) {
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+
+	level = l
+	ShowDebug = l <= LevelDebug
+	ShowOutput = l <= LevelInfo
+}
+
+// GetLevel returns the level configured with SetLevel (LevelDebug by default).
+func GetLevel() Level {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+	return level
+}
+
+// enabled reports whether messages at l pass the configured level
+func enabled(l Level) bool {
+	return l >= GetLevel()
+}
@@ -0,0 +1,162 @@
+package logger
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSetLevel(t *testing.T) {
+	defer SetLevel(LevelDebug)
+
+	logAll := func() string {
+		return captureOutput(func() {
+			Debugf("debug line")
+			Infof("info line")
+			Warnf("warn line")
+			Errorf("error line")
+		})
+	}
+
+	tests := []struct {
+		level    Level
+		expected []string
+		hidden   []string
+	}{
+		{LevelDebug, []string{"DEBUG: debug line", "INFO: info line", "WARN: warn line", "ERROR: error line"}, nil},
+		{LevelInfo, []string{"INFO: info line", "WARN: warn line", "ERROR: error line"}, []string{"DEBUG"}},
+		{LevelWarn, []string{"WARN: warn line", "ERROR: error line"}, []string{"DEBUG", "INFO"}},
+		{LevelError, []string{"ERROR: error line"}, []string{"DEBUG", "INFO", "WARN"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.level.String(), func(t *testing.T) {
+			SetLevel(tt.level)
+			if GetLevel() != tt.level {
+				t.Errorf("Expected GetLevel %s, got %s", tt.level, GetLevel())
+			}
+
+			output := logAll()
+			for _, want := range tt.expected {
+				if !strings.Contains(output, want) {
+					t.Errorf("Expected %q in output, got: %q", want, output)
+				}
+			}
+			for _, hidden := range tt.hidden {
+				if strings.Contains(output, hidden+":") {
+					t.Errorf("Expected no %s line, got: %q", hidden, output)
+				}
+			}
+		})
+	}
+}
+
+func TestSetLevelDerivesFlags(t *testing.T) {
+	defer SetLevel(LevelDebug)
+
+	tests := []struct {
+		level      Level
+		showDebug  bool
+		showOutput bool
+	}{
+		{LevelDebug, true, true},
+		{LevelInfo, false, true},
+		{LevelWarn, false, false},
+		{LevelError, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.level.String(), func(t *testing.T) {
+			SetLevel(tt.level)
+			if ShowDebug != tt.showDebug {
+				t.Errorf("Expected ShowDebug=%v, got %v", tt.showDebug, ShowDebug)
+			}
+			if ShowOutput != tt.showOutput {
+				t.Errorf("Expected ShowOutput=%v, got %v", tt.showOutput, ShowOutput)
+			}
+		})
+	}
+
+	t.Run("printf_follows_level", func(t *testing.T) {
+		SetLevel(LevelWarn)
+		if output := captureOutput(func() { Printf("hidden") }); output != "" {
+			t.Errorf("Expected Printf suppressed at WARN, got: %q", output)
+		}
+	})
+
+	t.Run("show_debug_still_disables_debugf", func(t *testing.T) {
+		SetLevel(LevelDebug)
+		ShowDebug = false
+		if output := captureOutput(func() { Debugf("hidden") }); output != "" {
+			t.Errorf("Expected Debugf suppressed by ShowDebug, got: %q", output)
+		}
+	})
+}
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		input     string
+		expected  Level
+		expectErr bool
+	}{
+		{"debug", LevelDebug, false},
+		{"INFO", LevelInfo, false},
+		{"Warn", LevelWarn, false},
+		{"warning", LevelWarn, false},
+		{" error ", LevelError, false},
+		{"verbose", LevelDebug, true},
+		{"", LevelDebug, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseLevel(tt.input)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("Expected error for %q", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+
+	if s := Level(9).String(); s != "Level(9)" {
+		t.Errorf("Expected Level(9), got %s", s)
+	}
+}
+
+func TestSettingsConcurrentWithLogging(t *testing.T) {
+	defer SetLevel(LevelDebug)
+	defer SetTimestampFormat("")
+
+	// Run with -race: changing settings while logging must not race
+	captureOutput(func() {
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 50; j++ {
+					SetLevel(Level(j % 4))
+					SetTimestampFormat(time.RFC3339)
+				}
+			}()
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 50; j++ {
+					Debugf("debug %d", j)
+					Printf("print %d", j)
+					Warnf("warn %d", j)
+				}
+			}()
+		}
+		wg.Wait()
+	})
+}
@@ -4,16 +4,120 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"log"
+	"io"
+	"os"
+	"runtime/debug"
+	"sync"
 	"time"
 )
 
+// ShowOutput, ShowDebug and TimestampFormat are guarded by settingsMu. SetLevel and
+// SetTimestampFormat may be called while other goroutines log; assigning the variables
+// directly is only safe during startup, before any goroutine logs.
 var (
+	// ShowOutput controls Printf, PrintHex and FiberMiddleware. SetLevel derives it from the level.
 	ShowOutput = true
-	ShowDebug  = true
+	// ShowDebug controls Debugf. SetLevel derives it from the level.
+	ShowDebug = true
+
+	// ShowStackTrace appends a goroutine stack trace to Errorf output.
+	// Intended for local development; keep it disabled in production.
+	ShowStackTrace = false
 )
 
-// Vardump prints a formatted JSON representation of the given value to standard output.
+// DefaultTimestampFormat is the timestamp layout used when TimestampFormat is not changed.
+const DefaultTimestampFormat = "2006-01-02 15:04:05"
+
+// TimestampFormat is the layout of the [timestamp] prefix on every log line.
+// Change it with SetTimestampFormat, e.g., to time.RFC3339 or "2006-01-02 15:04:05.000".
+// Default: DefaultTimestampFormat
+var TimestampFormat = DefaultTimestampFormat
+
+// output is where every logging function writes, see SetOutput
+var output io.Writer = os.Stdout
+
+// mu serializes writes to output so lines from concurrent goroutines are not interleaved
+var mu sync.Mutex
+
+// settingsMu guards the level, ShowOutput, ShowDebug and TimestampFormat
+var settingsMu sync.RWMutex
+
+// SetOutput redirects all logger output, including FiberMiddleware access lines, to w.
+// Passing nil restores the default of os.Stdout.
+//
+// Parameters:
+//   - w: Destination writer (e.g., a file, bytes.Buffer or io.MultiWriter)
+//
+// Example:
+//
+//	f, err := os.OpenFile("app.log", os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+//	if err != nil {
+//	    logger.Fatalf("cannot open log file: %v", err)
+//	}
+//	logger.SetOutput(io.MultiWriter(os.Stdout, f))
+func SetOutput(w io.Writer) {
+	if w == nil {
+		w = os.Stdout
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	output = w
+}
+
+// write formats one log entry and writes it to output while holding mu
+func write(format string, args ...interface{}) {
+	mu.Lock()
+	defer mu.Unlock()
+	fmt.Fprintf(output, format, args...)
+}
+
+// SetTimestampFormat sets the layout of the [timestamp] prefix on every log line.
+// Passing an empty string restores DefaultTimestampFormat.
+//
+// Parameters:
+//   - layout: A time layout following time.Format conventions
+//
+// It is safe to call while other goroutines are logging.
+//
+// Example:
+//
+//	logger.SetTimestampFormat("2006-01-02 15:04:05.000")
+//	logger.Infof("server started")
+//	// Output: [2025-11-15 04:56:56.123] INFO: server started
+func SetTimestampFormat(layout string) {
+	if layout == "" {
+		layout = DefaultTimestampFormat
+	}
+
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+	TimestampFormat = layout
+}
+
+// timestamp returns the current time formatted with TimestampFormat
+func timestamp() string {
+	settingsMu.RLock()
+	layout := TimestampFormat
+	settingsMu.RUnlock()
+	return time.Now().Format(layout)
+}
+
+// showOutput returns ShowOutput while holding settingsMu
+func showOutput() bool {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+	return ShowOutput
+}
+
+// showDebug returns ShowDebug while holding settingsMu
+func showDebug() bool {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+	return ShowDebug
+}
+
+// Vardump prints a formatted JSON representation of the given value to the configured output.
 // It uses json.MarshalIndent with 2-space indentation for readable output.
 // Any marshaling errors are silently ignored.
 //
@@ -35,13 +139,13 @@ var (
 //	// }
 func Vardump(v any) {
 	b, _ := json.MarshalIndent(v, "", "  ")
-	fmt.Println(string(b))
+	write("%s\n", b)
 }
 
 // Printf formats and prints a log message with a timestamp prefix.
 // The message is only printed if ShowOutput is true.
 // The format string and arguments follow the same conventions as fmt.Sprintf.
-// Each log entry is prefixed with the current timestamp in "2006-01-02 15:04:05" format.
+// Each log entry is prefixed with the current timestamp in TimestampFormat.
 //
 // Parameters:
 //   - format: A format string following fmt.Sprintf conventions
@@ -51,20 +155,20 @@ func Vardump(v any) {
 //
 //	Printf("User %s logged in at %d", username, loginTime)
 func Printf(format string, args ...interface{}) {
-	if ShowOutput {
+	if showOutput() {
 		text := fmt.Sprintf(format, args...)
-		now := time.Now().Format("2006-01-02 15:04:05")
-		fmt.Printf("[%s] %s\n", now, text)
+		now := timestamp()
+		write("[%s] %s\n", now, text)
 	}
 }
 
-// PrintHex prints the hexadecimal representation of the provided byte slice to stdout.
-// The output includes a timestamp in the format "2006-01-02 15:04:05" followed by the
+// PrintHex prints the hexadecimal representation of the provided byte slice to the configured output.
+// The output includes a timestamp in TimestampFormat followed by the
 // hex-encoded data. The function only produces output if the ShowOutput flag is set to true.
 func PrintHex(data []byte) {
-	if ShowOutput {
-		now := time.Now().Format("2006-01-02 15:04:05")
-		fmt.Printf("[%s] %s\n", now, hex.EncodeToString(data))
+	if showOutput() {
+		now := timestamp()
+		write("[%s] %s\n", now, hex.EncodeToString(data))
 	}
 }
 
@@ -76,19 +180,20 @@ func PrintHex(data []byte) {
 //   - format: A format string following fmt.Sprintf conventions
 //   - args: Variable number of arguments to be formatted according to the format string
 //
-// The function will only produce output when the global ShowDebug flag is set to true.
+// The function will only produce output when the global ShowDebug flag is set to true
+// and the level set with SetLevel is LevelDebug.
 func Debugf(format string, args ...interface{}) {
-	if ShowDebug {
+	if showDebug() && enabled(LevelDebug) {
 		text := fmt.Sprintf(format, args...)
-		now := time.Now().Format("2006-01-02 15:04:05")
-		fmt.Printf("[%s] DEBUG: %s\n", now, text)
+		now := timestamp()
+		write("[%s] DEBUG: %s\n", now, text)
 	}
 }
 
 // Fatalf logs a formatted fatal message with timestamp and terminates the program with exit code 1.
 // The function formats the message according to the format specifier and arguments provided,
-// prepends it with the current timestamp in "2006-01-02 15:04:05" format and "FATAL" level,
-// then calls log.Fatalf which prints the message and exits the program.
+// prepends it with the current timestamp in TimestampFormat and "FATAL" level,
+// then writes it to the configured output and exits the program.
 //
 // Parameters:
 //   - format: A format string following fmt.Printf conventions
@@ -97,14 +202,17 @@ func Debugf(format string, args ...interface{}) {
 // Note: This function does not return as it terminates the program execution.
 func Fatalf(format string, args ...interface{}) {
 	text := fmt.Sprintf(format, args...)
-	now := time.Now().Format("2006-01-02 15:04:05")
-	log.Fatalf("[%s] FATAL: %s\n", now, text)
+	now := timestamp()
+	write("[%s] FATAL: %s\n", now, text)
+	os.Exit(1)
 }
 
 // Errorf logs an error message with formatted arguments.
 // It formats the message using fmt.Sprintf with the provided format string and arguments,
-// then prints it to standard output with an ERROR prefix and current timestamp.
-// The timestamp format is "2006-01-02 15:04:05".
+// then prints it to the configured output with an ERROR prefix and current timestamp.
+// The timestamp format is TimestampFormat.
+// When ShowStackTrace is true, the stack trace of the calling goroutine is printed after the message.
+// Errorf is written at every level.
 //
 // Parameters:
 //   - format: A format string following fmt.Sprintf conventions
@@ -114,15 +222,22 @@ func Fatalf(format string, args ...interface{}) {
 //
 //	logger.Errorf("failed to connect to database: %v", err)
 func Errorf(format string, args ...interface{}) {
+	if !enabled(LevelError) {
+		return
+	}
 	text := fmt.Sprintf(format, args...)
-	now := time.Now().Format("2006-01-02 15:04:05")
-	fmt.Printf("[%s] ERROR: %s\n", now, text)
+	now := timestamp()
+	if ShowStackTrace {
+		write("[%s] ERROR: %s\n%s", now, text, debug.Stack())
+		return
+	}
+	write("[%s] ERROR: %s\n", now, text)
 }
 
 // Infof logs an informational message with formatted output.
 // It formats the message according to the format specifier and arguments,
-// prefixes it with a timestamp in "2006-01-02 15:04:05" format and "INFO" level,
-// then outputs to standard output.
+// prefixes it with a timestamp in TimestampFormat and "INFO" level,
+// then writes it to the configured output. It is suppressed when the level is above LevelInfo.
 //
 // Parameters:
 //   - format: A format string following fmt.Sprintf conventions
@@ -132,7 +247,31 @@ func Errorf(format string, args ...interface{}) {
 //
 //	Infof("User %s logged in at %d", username, loginTime)
 func Infof(format string, args ...interface{}) {
+	if !enabled(LevelInfo) {
+		return
+	}
+	text := fmt.Sprintf(format, args...)
+	now := timestamp()
+	write("[%s] INFO: %s\n", now, text)
+}
+
+// Warnf logs a warning message with formatted output.
+// It formats the message according to the format specifier and arguments,
+// prefixes it with a timestamp in TimestampFormat and "WARN" level,
+// then writes it to the configured output. It is suppressed when the level is LevelError.
+//
+// Parameters:
+//   - format: A format string following fmt.Sprintf conventions
+//   - args: Variable arguments to be formatted according to the format string
+//
+// Example:
+//
+//	Warnf("Cache miss rate at %d%%", rate)
+func Warnf(format string, args ...interface{}) {
+	if !enabled(LevelWarn) {
+		return
+	}
 	text := fmt.Sprintf(format, args...)
-	now := time.Now().Format("2006-01-02 15:04:05")
-	fmt.Printf("[%s] INFO: %s\n", now, text)
+	now := timestamp()
+	write("[%s] WARN: %s\n", now, text)
 }
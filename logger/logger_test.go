@@ -4,27 +4,25 @@ import (
 	"bytes"
 	"io"
 	"os"
+	"regexp"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 // ============================================================================
 // Helper Functions
 // ============================================================================
 
-// captureOutput captures stdout during function execution
+// captureOutput captures logger output during function execution
 func captureOutput(f func()) string {
-	old := os.Stdout
-	r, w, _ := os.Pipe()
-	os.Stdout = w
+	var buf bytes.Buffer
+	SetOutput(&buf)
+	defer SetOutput(os.Stdout)
 
 	f()
 
-	w.Close()
-	os.Stdout = old
-
-	var buf bytes.Buffer
-	io.Copy(&buf, r)
 	return buf.String()
 }
 
@@ -376,6 +374,32 @@ func TestErrorf(t *testing.T) {
 		}
 	})
 
+	t.Run("stack_trace", func(t *testing.T) {
+		ShowStackTrace = true
+		defer func() { ShowStackTrace = false }()
+
+		output := captureOutput(func() {
+			Errorf("Something went wrong")
+		})
+
+		if !strings.Contains(output, "ERROR: Something went wrong\n") {
+			t.Errorf("Expected error message, got: %s", output)
+		}
+		if !strings.Contains(output, "goroutine ") || !strings.Contains(output, "logger_test.go") {
+			t.Errorf("Expected stack trace with caller frame, got: %s", output)
+		}
+	})
+
+	t.Run("no_stack_trace_by_default", func(t *testing.T) {
+		output := captureOutput(func() {
+			Errorf("Something went wrong")
+		})
+
+		if strings.Contains(output, "goroutine ") {
+			t.Errorf("Expected no stack trace, got: %s", output)
+		}
+	})
+
 	t.Run("multiple_placeholders", func(t *testing.T) {
 		output := captureOutput(func() {
 			Errorf("User %s failed login attempt %d", "john", 3)
@@ -596,3 +620,151 @@ func TestTimestampFormat(t *testing.T) {
 		}
 	})
 }
+
+func TestSetTimestampFormat(t *testing.T) {
+	defer SetTimestampFormat("")
+
+	t.Run("default_format", func(t *testing.T) {
+		if TimestampFormat != "2006-01-02 15:04:05" {
+			t.Errorf("Expected default format to be unchanged, got %q", TimestampFormat)
+		}
+		output := captureOutput(func() {
+			Infof("test")
+		})
+		if !regexp.MustCompile(`^\[\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}\] INFO: test\n$`).MatchString(output) {
+			t.Errorf("Expected default timestamp, got: %q", output)
+		}
+	})
+
+	t.Run("custom_format_applies_to_all_functions", func(t *testing.T) {
+		ShowOutput = true
+		ShowDebug = true
+		SetTimestampFormat(time.RFC3339)
+
+		output := captureOutput(func() {
+			Printf("printf")
+			PrintHex([]byte{0x01})
+			Debugf("debug")
+			Infof("info")
+			Warnf("warn")
+			Errorf("error")
+		})
+
+		pattern := regexp.MustCompile(`^\[\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(Z|[+-]\d{2}:\d{2})\] `)
+		lines := strings.Split(strings.TrimSuffix(output, "\n"), "\n")
+		if len(lines) != 6 {
+			t.Fatalf("Expected 6 lines, got %d: %q", len(lines), output)
+		}
+		for _, line := range lines {
+			if !pattern.MatchString(line) {
+				t.Errorf("Expected RFC3339 timestamp, got: %q", line)
+			}
+		}
+	})
+
+	t.Run("milliseconds", func(t *testing.T) {
+		SetTimestampFormat("2006-01-02 15:04:05.000")
+		output := captureOutput(func() {
+			Infof("test")
+		})
+		if !regexp.MustCompile(`^\[\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}\.\d{3}\] INFO: test\n$`).MatchString(output) {
+			t.Errorf("Expected millisecond timestamp, got: %q", output)
+		}
+	})
+
+	t.Run("empty_restores_default", func(t *testing.T) {
+		SetTimestampFormat(time.Kitchen)
+		SetTimestampFormat("")
+		if TimestampFormat != DefaultTimestampFormat {
+			t.Errorf("Expected default format, got %q", TimestampFormat)
+		}
+	})
+}
+
+// ============================================================================
+// SetOutput Tests
+// ============================================================================
+
+func TestSetOutput(t *testing.T) {
+	defer SetOutput(os.Stdout)
+
+	t.Run("all_functions_write_to_writer", func(t *testing.T) {
+		ShowOutput = true
+		ShowDebug = true
+
+		var buf bytes.Buffer
+		SetOutput(&buf)
+
+		Vardump(map[string]int{"a": 1})
+		Printf("printf line")
+		PrintHex([]byte{0xAB})
+		Debugf("debug line")
+		Infof("info line")
+		Warnf("warn line")
+		Errorf("error line")
+
+		output := buf.String()
+		for _, want := range []string{`"a": 1`, "] printf line", "] ab", "DEBUG: debug line", "INFO: info line", "WARN: warn line", "ERROR: error line"} {
+			if !strings.Contains(output, want) {
+				t.Errorf("Expected %q in output, got: %q", want, output)
+			}
+		}
+		if lines := strings.Count(output, "\n"); lines != 9 {
+			t.Errorf("Expected 9 lines, got %d: %q", lines, output)
+		}
+	})
+
+	t.Run("nil_restores_stdout", func(t *testing.T) {
+		SetOutput(nil)
+		if output != os.Stdout {
+			t.Errorf("Expected os.Stdout after SetOutput(nil), got %T", output)
+		}
+	})
+}
+
+// ============================================================================
+// Concurrency Tests
+// ============================================================================
+
+func TestConcurrentWrites(t *testing.T) {
+	ShowOutput = true
+	ShowDebug = true
+
+	const goroutines = 50
+	const perGoroutine = 100
+
+	output := captureOutput(func() {
+		var wg sync.WaitGroup
+		for g := 0; g < goroutines; g++ {
+			wg.Add(1)
+			go func(g int) {
+				defer wg.Done()
+				for i := 0; i < perGoroutine; i++ {
+					switch i % 4 {
+					case 0:
+						Printf("goroutine %d message %d", g, i)
+					case 1:
+						Infof("goroutine %d message %d", g, i)
+					case 2:
+						Warnf("goroutine %d message %d", g, i)
+					default:
+						Debugf("goroutine %d message %d", g, i)
+					}
+				}
+			}(g)
+		}
+		wg.Wait()
+	})
+
+	lines := strings.Split(strings.TrimSuffix(output, "\n"), "\n")
+	if len(lines) != goroutines*perGoroutine {
+		t.Fatalf("Expected %d lines, got %d", goroutines*perGoroutine, len(lines))
+	}
+
+	pattern := regexp.MustCompile(`^\[\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}\] ((INFO|WARN|DEBUG): )?goroutine \d+ message \d+$`)
+	for _, line := range lines {
+		if !pattern.MatchString(line) {
+			t.Fatalf("Expected whole log line, got: %q", line)
+		}
+	}
+}
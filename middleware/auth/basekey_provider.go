@@ -5,6 +5,9 @@ import (
 	"sync"
 )
 
+// ErrKeyNotFound is returned when the requested key does not exist in the key provider.
+var ErrKeyNotFound = errors.New("key not found")
+
 // BaseKeyProvider is a concrete implementation of the BaseKey interface.
 type BaseKeyProvider struct {
 	keys map[string]string
@@ -73,7 +76,7 @@ func (b *BaseKeyProvider) GetValue(key string) (string, error) {
 	defer b.mu.RUnlock()
 	value, exists := b.keys[key]
 	if !exists {
-		return "", errors.New("key not found")
+		return "", ErrKeyNotFound
 	}
 	return value, nil
 }
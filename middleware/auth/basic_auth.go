@@ -2,17 +2,68 @@ package auth
 
 import (
 	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"strings"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/basicauth"
+	"golang.org/x/crypto/bcrypt"
 )
 
+// ErrBasicAuthInvalid is passed to BasicAuthConfig.FailureLogger when the credentials are
+// missing, malformed, or do not match.
+var ErrBasicAuthInvalid = errors.New("missing or invalid basic auth credentials")
+
+// HashComparer reports whether the password sent by the client matches the value
+// stored in the KeyProvider (a plaintext password or a hash of it).
+type HashComparer func(password, stored string) bool
+
+// PlaintextComparer compares the password with the stored plaintext value in constant time.
+// It is the default HashComparer.
+func PlaintextComparer(password, stored string) bool {
+	return subtle.ConstantTimeCompare([]byte(password), []byte(stored)) == 1
+}
+
+// BcryptComparer compares the password with a stored bcrypt hash (e.g., "$2a$10$...").
+//
+// Example:
+//
+//	hash, _ := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.DefaultCost)
+//	keyProvider.AddKeyValue("admin", string(hash))
+//
+//	basicAuth := auth.NewBasicAuth(auth.BasicAuthConfig{
+//	    KeyProvider:  keyProvider,
+//	    HashComparer: auth.BcryptComparer,
+//	})
+func BcryptComparer(password, stored string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(stored), []byte(password)) == nil
+}
+
 // BasicAuthConfig defines the configuration for BasicAuth middleware.
 type BasicAuthConfig struct {
 	KeyProvider     BaseKey
 	Unauthorized    fiber.Handler
 	ContextUsername string
 	ContextPassword string
+
+	// HashComparer checks the request password against the stored value.
+	// Use BcryptComparer to store bcrypt hashes instead of plaintext passwords.
+	// Default: PlaintextComparer
+	HashComparer HashComparer
+
+	// Filter defines a function to skip this middleware when it returns true,
+	// e.g. for health checks or metrics endpoints. Checked before SkipPaths.
+	Filter func(c *fiber.Ctx) bool
+
+	// SkipPaths lists paths that bypass authentication.
+	// Entries are anchored: "/health" matches only that path, while a trailing "*"
+	// matches a prefix (e.g., "/public/*" matches "/public" and everything below it).
+	SkipPaths []string
+
+	// FailureLogger is called when authentication fails, with the username masked by MaskKey.
+	// The password is never reported.
+	FailureLogger FailureLogger
 }
 
 // BasicAuth provides Basic Authentication middleware for Fiber.
@@ -22,6 +73,11 @@ type BasicAuth struct {
 
 // NewBasicAuth creates a new instance of BasicAuth middleware with the provided configuration.
 func NewBasicAuth(config BasicAuthConfig) *BasicAuth {
+	// Set defaults
+	if config.HashComparer == nil {
+		config.HashComparer = PlaintextComparer
+	}
+
 	return &BasicAuth{
 		config: config,
 	}
@@ -30,6 +86,10 @@ func NewBasicAuth(config BasicAuthConfig) *BasicAuth {
 // Middleware returns the Fiber middleware handler for Basic Authentication.
 func (b *BasicAuth) Middleware() fiber.Handler {
 	return basicauth.New(basicauth.Config{
+		// Let exempted paths through without authentication
+		Next: func(c *fiber.Ctx) bool {
+			return shouldSkip(c, b.config.Filter, b.config.SkipPaths)
+		},
 		Users: nil,
 		Authorizer: func(user, pass string) bool {
 			// retrieve password from KeyProvider
@@ -38,13 +98,50 @@ func (b *BasicAuth) Middleware() fiber.Handler {
 			if err != nil {
 				return false
 			}
-			if subtle.ConstantTimeCompare([]byte(pass), []byte(storedPass)) == 1 {
-				return true
-			}
-			return false
+			return b.config.HashComparer(pass, storedPass)
 		},
-		Unauthorized:    b.config.Unauthorized,
+		Unauthorized:    b.unauthorizedHandler(),
 		ContextUsername: b.config.ContextUsername,
 		ContextPassword: b.config.ContextPassword,
 	})
 }
+
+// unauthorizedHandler wraps the Unauthorized handler so that failures are reported to the
+// FailureLogger with the masked username first. Without a custom handler, basicauth's default
+// 401 with a WWW-Authenticate challenge is sent.
+func (b *BasicAuth) unauthorizedHandler() fiber.Handler {
+	handler := b.config.Unauthorized
+	logger := b.config.FailureLogger
+	if logger == nil {
+		return handler
+	}
+	if handler == nil {
+		handler = func(c *fiber.Ctx) error {
+			c.Set(fiber.HeaderWWWAuthenticate, "basic realm="+basicauth.ConfigDefault.Realm)
+			return c.SendStatus(fiber.StatusUnauthorized)
+		}
+	}
+
+	return func(c *fiber.Ctx) error {
+		logger(c, MaskKey(basicAuthUsername(c)), ErrBasicAuthInvalid)
+		return handler(c)
+	}
+}
+
+// basicAuthUsername returns the username from the Authorization header,
+// or an empty string if the header holds no Basic credentials.
+func basicAuthUsername(c *fiber.Ctx) string {
+	header := c.Get(fiber.HeaderAuthorization)
+	if len(header) <= 6 || !strings.EqualFold(header[:6], "basic ") {
+		return ""
+	}
+	raw, err := base64.StdEncoding.DecodeString(header[6:])
+	if err != nil {
+		return ""
+	}
+	username, _, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return ""
+	}
+	return username
+}
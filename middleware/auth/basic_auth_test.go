@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/gofiber/fiber/v2"
+	"golang.org/x/crypto/bcrypt"
 )
 
 func TestNewBasicAuth(t *testing.T) {
@@ -419,3 +420,59 @@ func TestBasicAuth_Middleware_UpdateCredentials(t *testing.T) {
 		t.Errorf("Expected status 200 with new password, got %d", resp.StatusCode)
 	}
 }
+
+func TestBasicAuth_Middleware_BcryptComparer(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret123"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyProvider := NewBaseKeyProvider()
+	keyProvider.AddKeyValue("admin", string(hash))
+
+	basicAuth := NewBasicAuth(BasicAuthConfig{
+		KeyProvider:  keyProvider,
+		HashComparer: BcryptComparer,
+	})
+
+	app := fiber.New()
+	app.Use(basicAuth.Middleware())
+	app.Get("/test", func(c *fiber.Ctx) error {
+		return c.SendString("Success")
+	})
+
+	tests := []struct {
+		name        string
+		credentials string
+		wantStatus  int
+	}{
+		{"correct_password", "admin:secret123", fiber.StatusOK},
+		{"wrong_password", "admin:wrong", fiber.StatusUnauthorized},
+		{"hash_as_password", "admin:" + string(hash), fiber.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/test", nil)
+			req.Header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(tt.credentials)))
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("Failed to make request: %v", err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+		})
+	}
+}
+
+func TestNewBasicAuth_DefaultHashComparer(t *testing.T) {
+	basicAuth := NewBasicAuth(BasicAuthConfig{KeyProvider: NewBaseKeyProvider()})
+	if basicAuth.config.HashComparer == nil {
+		t.Fatal("Expected default HashComparer to be set")
+	}
+	if !basicAuth.config.HashComparer("secret", "secret") || basicAuth.config.HashComparer("secret", "other") {
+		t.Error("Expected default HashComparer to compare plaintext")
+	}
+}
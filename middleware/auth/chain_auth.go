@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"github.com/budimanlai/go-pkg/internal/chain"
+	"github.com/gofiber/fiber/v2"
+	"github.com/valyala/fasthttp"
+)
+
+// AuthMiddleware is implemented by every authenticator in this package
+// (JWTAuth, HeaderAuth, QueryStringAuth, BasicAuth and ChainAuth).
+type AuthMiddleware interface {
+	Middleware() fiber.Handler
+}
+
+// ChainAuth accepts a request if any of several authentication schemes succeeds,
+// e.g. either a Bearer JWT or an X-API-Key header.
+type ChainAuth struct {
+	chains []*chain.Chain
+}
+
+// NewChainAuth creates an authenticator that tries each middleware in order.
+// The request is authorized by the first middleware that succeeds, keeping the locals it
+// set (claims, tokens) for downstream handlers. Locals, headers and bodies set by failed
+// attempts are discarded. Only if all of them fail is 401 returned.
+//
+// Example:
+//
+//	chain := auth.NewChainAuth(jwtAuth, headerAuth)
+//	app.Get("/reports", chain.Middleware(), handler)
+func NewChainAuth(auths ...AuthMiddleware) *ChainAuth {
+	chains := make([]*chain.Chain, len(auths))
+	for i, a := range auths {
+		chains[i] = chain.New(a.Middleware())
+	}
+	return &ChainAuth{chains: chains}
+}
+
+// Middleware returns the Fiber middleware handler for chained authentication.
+func (ca *ChainAuth) Middleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		// Keep the response and locals as they were before the chain, so a failed
+		// attempt's 401 body, headers and partial locals (e.g. claims rejected by a
+		// SuccessHandler) can be discarded before trying the next scheme
+		saved := fasthttp.AcquireResponse()
+		defer fasthttp.ReleaseResponse(saved)
+		c.Response().CopyTo(saved)
+		locals := saveLocals(c)
+
+		for _, ch := range ca.chains {
+			// Errors from a failing authenticator are treated as a failed attempt
+			if passed, _ := ch.Run(c, nil); passed {
+				return c.Next()
+			}
+			saved.CopyTo(c.Response())
+			restoreLocals(c, locals)
+		}
+
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error":   "Unauthorized",
+			"message": "Missing or invalid credentials",
+		})
+	}
+}
+
+// saveLocals returns a copy of the request locals
+func saveLocals(c *fiber.Ctx) map[any]any {
+	locals := make(map[any]any)
+	c.Context().VisitUserValuesAll(func(key, value any) {
+		locals[key] = value
+	})
+	return locals
+}
+
+// restoreLocals resets the request locals to a copy taken by saveLocals
+func restoreLocals(c *fiber.Ctx, locals map[any]any) {
+	var added []any
+	c.Context().VisitUserValuesAll(func(key, _ any) {
+		if _, ok := locals[key]; !ok {
+			added = append(added, key)
+		}
+	})
+	for _, key := range added {
+		c.Context().RemoveUserValue(key)
+	}
+	for key, value := range locals {
+		c.Context().SetUserValue(key, value)
+	}
+}
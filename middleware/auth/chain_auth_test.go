@@ -0,0 +1,176 @@
+package auth
+
+import (
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestChainAuth(t *testing.T) {
+	secretKey := "test-secret-key"
+	jwtAuth := NewJWTAuth(JWTConfig{SecretKey: secretKey})
+
+	keyProvider := NewBaseKeyProvider()
+	keyProvider.Add("valid-api-key")
+	headerAuth := NewHeaderAuth(HeaderAuthConfig{KeyProvider: keyProvider})
+
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.Set("X-Before", "kept")
+		return c.Next()
+	})
+	app.Use(NewChainAuth(jwtAuth, headerAuth).Middleware())
+	app.Get("/test", func(c *fiber.Ctx) error {
+		if claims, ok := c.Locals("claims").(jwt.MapClaims); ok {
+			return c.SendString("jwt:" + claims["sub"].(string))
+		}
+		if token, ok := c.Locals("token").(string); ok {
+			return c.SendString("key:" + token)
+		}
+		return c.SendString("none")
+	})
+
+	validJWT := generateTestToken(secretKey, jwt.MapClaims{
+		"sub": "user-1",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}, "HS256")
+
+	tests := []struct {
+		name       string
+		headers    map[string]string
+		wantStatus int
+		wantBody   string
+	}{
+		{"jwt", map[string]string{"Authorization": "Bearer " + validJWT}, fiber.StatusOK, "jwt:user-1"},
+		{"api_key", map[string]string{"X-API-Key": "valid-api-key"}, fiber.StatusOK, "key:valid-api-key"},
+		{"invalid_jwt_valid_key", map[string]string{"Authorization": "Bearer invalid", "X-API-Key": "valid-api-key"}, fiber.StatusOK, "key:valid-api-key"},
+		{"invalid_both", map[string]string{"Authorization": "Bearer invalid", "X-API-Key": "wrong"}, fiber.StatusUnauthorized, `{"error":"Unauthorized","message":"Missing or invalid credentials"}`},
+		{"no_credentials", nil, fiber.StatusUnauthorized, `{"error":"Unauthorized","message":"Missing or invalid credentials"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/test", nil)
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			body, _ := io.ReadAll(resp.Body)
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+			if string(body) != tt.wantBody {
+				t.Errorf("Expected body %q, got %q", tt.wantBody, string(body))
+			}
+			if resp.Header.Get("X-Before") != "kept" {
+				t.Error("Expected headers set before the chain to be kept")
+			}
+		})
+	}
+}
+
+func TestChainAuth_Nested(t *testing.T) {
+	keyProvider := NewBaseKeyProvider()
+	keyProvider.Add("valid-api-key")
+
+	inner := NewChainAuth(NewHeaderAuth(HeaderAuthConfig{KeyProvider: keyProvider}))
+	outer := NewChainAuth(NewJWTAuth(JWTConfig{SecretKey: "secret"}), inner)
+
+	app := fiber.New()
+	app.Get("/test", outer.Middleware(), func(c *fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.Header.Set("X-API-Key", "valid-api-key")
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != fiber.StatusOK {
+		t.Errorf("Expected status 200, got %d", resp.StatusCode)
+	}
+}
+
+func TestChainAuth_DiscardsFailedAttemptLocals(t *testing.T) {
+	jwtAuth := NewJWTAuth(JWTConfig{
+		SecretKey: "test-secret-key",
+		SuccessHandler: func(c *fiber.Ctx, claims jwt.MapClaims) error {
+			return errors.New("account disabled")
+		},
+	})
+	token, err := jwtAuth.GenerateToken(jwt.MapClaims{"sub": "disabled-user"}, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyProvider := NewBaseKeyProvider()
+	keyProvider.Add("valid-api-key")
+	headerAuth := NewHeaderAuth(HeaderAuthConfig{KeyProvider: keyProvider})
+
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("request_id", "req-1")
+		return c.Next()
+	})
+	app.Get("/users/:id", NewChainAuth(jwtAuth, headerAuth).Middleware(), func(c *fiber.Ctx) error {
+		if _, ok := c.Locals("claims").(jwt.MapClaims); ok {
+			return c.SendString("stale claims")
+		}
+		return c.SendString(c.Locals("request_id").(string) + ":" + c.Params("id"))
+	})
+
+	req := httptest.NewRequest("GET", "/users/42", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("X-API-Key", "valid-api-key")
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != fiber.StatusOK || string(body) != "req-1:42" {
+		t.Errorf("Expected 200 without the rejected JWT claims, got %d %q", resp.StatusCode, body)
+	}
+}
+
+func TestChainAuth_RouteParams(t *testing.T) {
+	var seen string
+	byParam := &paramAuth{seen: &seen}
+
+	app := fiber.New()
+	app.Get("/orgs/:org", NewChainAuth(byParam).Middleware(), func(c *fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/orgs/acme", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != fiber.StatusOK || seen != "acme" {
+		t.Errorf("Expected authenticator to see route params, got %d and %q", resp.StatusCode, seen)
+	}
+}
+
+// paramAuth authorizes requests by route param, to check params reach chained authenticators
+type paramAuth struct {
+	seen *string
+}
+
+func (p *paramAuth) Middleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		*p.seen = c.Params("org")
+		if *p.seen == "" {
+			return c.SendStatus(fiber.StatusUnauthorized)
+		}
+		return c.Next()
+	}
+}
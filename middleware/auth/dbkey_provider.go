@@ -1,12 +1,18 @@
 package auth
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 // ApiKey represents the API key model in the database.
+// A key is valid while its Status is "active" and ExpiresAt is nil or in the future.
 type ApiKey struct {
-	ApiKey  string `gorm:"uniqueIndex;not null"`
-	AuthKey string `gorm:"not null"`
-	Status  string `gorm:"not null;default:'active'"`
+	ApiKey    string     `gorm:"uniqueIndex;not null"`
+	AuthKey   string     `gorm:"not null"`
+	Status    string     `gorm:"not null;default:'active'"`
+	ExpiresAt *time.Time `gorm:"index"`
 }
 
 // TableName sets the table name for the ApiKey model.
@@ -35,18 +41,26 @@ func (dk *DbKeyProvider) RemoveAll() error {
 	return nil
 }
 
-// IsExists checks if the given key exists in the database.
+// validKey scopes a query to the given key if it is active and not expired.
+func validKey(key string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("api_key = ? AND status = 'active' AND (expires_at IS NULL OR expires_at > ?)", key, time.Now().UTC())
+	}
+}
+
+// IsExists checks if the given key exists in the database and is active and not expired.
 func (dk *DbKeyProvider) IsExists(key string) bool {
 	var count int64
 
-	dk.db.Model(&ApiKey{}).Where("api_key = ? AND status = 'active'", key).Count(&count)
+	dk.db.Model(&ApiKey{}).Scopes(validKey(key)).Count(&count)
 	return count > 0
 }
 
 // GetValue retrieves the value associated with the given key from the database.
+// Inactive and expired keys are reported as gorm.ErrRecordNotFound.
 func (dk *DbKeyProvider) GetValue(key string) (string, error) {
 	var apiKey ApiKey
-	result := dk.db.Where("api_key = ? and status = 'active'", key).First(&apiKey)
+	result := dk.db.Scopes(validKey(key)).First(&apiKey)
 	if result.Error != nil {
 		return "", result.Error
 	}
@@ -73,6 +87,23 @@ func (dk *DbKeyProvider) AddKeyValue(key string, value string) error {
 	return result.Error
 }
 
+// AddKeyValueWithExpiry adds a new key-value pair that stops being valid at expiresAt.
+//
+// Example:
+//
+//	provider := auth.NewDbKeyProvider(db).(*auth.DbKeyProvider)
+//	provider.AddKeyValueWithExpiry("partner-key", "partner-secret", time.Now().Add(30*24*time.Hour))
+func (dk *DbKeyProvider) AddKeyValueWithExpiry(key string, value string, expiresAt time.Time) error {
+	expiresAt = expiresAt.UTC()
+	apiKey := ApiKey{
+		ApiKey:    key,
+		AuthKey:   value,
+		ExpiresAt: &expiresAt,
+	}
+	result := dk.db.Create(&apiKey)
+	return result.Error
+}
+
 // Replace replaces all existing keys in the database with the provided key-value pairs.
 func (dk *DbKeyProvider) Replace(newKeys map[string]string) error {
 	// Start a transaction
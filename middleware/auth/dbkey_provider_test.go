@@ -1,7 +1,9 @@
 package auth
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
@@ -173,13 +175,16 @@ func TestDbApiKey_IsExists_InactiveKey(t *testing.T) {
 	dbApiKey.AddKeyValue("api-key-1", "auth-value-1")
 	db.Model(&ApiKey{}).Where("api_key = ?", "api-key-1").Update("status", "inactive")
 
-	// Test that inactive key is not counted as existing
-	// Note: The implementation has a bug in IsExists query, it should check status = 'active'
-	// but currently has a syntax error with "api_key = ? = 'active'"
-	exists := dbApiKey.IsExists("api-key-1")
-	// Due to the bug in the query, this test may not work as expected
-	// The query should be: WHERE api_key = ? AND status = 'active'
-	_ = exists // We acknowledge the current implementation might have issues
+	// Test that inactive (revoked) key is not counted as existing
+	if dbApiKey.IsExists("api-key-1") {
+		t.Error("Expected inactive key to not exist")
+	}
+
+	// Reactivating the key makes it valid again
+	db.Model(&ApiKey{}).Where("api_key = ?", "api-key-1").Update("status", "active")
+	if !dbApiKey.IsExists("api-key-1") {
+		t.Error("Expected reactivated key to exist")
+	}
 }
 
 func TestDbApiKey_Replace(t *testing.T) {
@@ -391,3 +396,52 @@ func TestDbApiKey_GormModel(t *testing.T) {
 	var apiKey ApiKey
 	db.Where("api_key = ?", "api-key-1").First(&apiKey)
 }
+
+func TestDbApiKey_Expiry(t *testing.T) {
+	db := setupTestDB(t)
+	provider := NewDbKeyProvider(db).(*DbKeyProvider)
+
+	if err := provider.AddKeyValueWithExpiry("valid-key", "valid-value", time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("Failed to add key: %v", err)
+	}
+	if err := provider.AddKeyValueWithExpiry("expired-key", "expired-value", time.Now().Add(-time.Minute)); err != nil {
+		t.Fatalf("Failed to add key: %v", err)
+	}
+	provider.AddKeyValue("permanent-key", "permanent-value")
+
+	t.Run("not_yet_expired", func(t *testing.T) {
+		if !provider.IsExists("valid-key") {
+			t.Error("Expected unexpired key to exist")
+		}
+		value, err := provider.GetValue("valid-key")
+		if err != nil || value != "valid-value" {
+			t.Errorf("Expected 'valid-value', got '%s', %v", value, err)
+		}
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		if provider.IsExists("expired-key") {
+			t.Error("Expected expired key to not exist")
+		}
+		if _, err := provider.GetValue("expired-key"); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Errorf("Expected gorm.ErrRecordNotFound, got %v", err)
+		}
+	})
+
+	t.Run("no_expiry", func(t *testing.T) {
+		if !provider.IsExists("permanent-key") {
+			t.Error("Expected key without expiry to exist")
+		}
+	})
+
+	t.Run("expires_over_time", func(t *testing.T) {
+		provider.AddKeyValueWithExpiry("short-key", "short-value", time.Now().Add(50*time.Millisecond))
+		if !provider.IsExists("short-key") {
+			t.Fatal("Expected key to exist before expiry")
+		}
+		time.Sleep(100 * time.Millisecond)
+		if provider.IsExists("short-key") {
+			t.Error("Expected key to be invalid after expiry")
+		}
+	})
+}
@@ -19,6 +19,22 @@ type HeaderAuthConfig struct {
 
 	// function called if the key is invalid or missing
 	ErrorHandler fiber.ErrorHandler
+
+	// IPResolver returns the IP allowlist (exact addresses or CIDR ranges) for a valid key.
+	// Requests from other IPs get 403 Forbidden; keys with no allowlist are unrestricted.
+	IPResolver IPResolver
+
+	// FailureLogger is called when authentication fails, with the key masked by MaskKey.
+	FailureLogger FailureLogger
+
+	// Filter defines a function to skip this middleware when it returns true,
+	// e.g. for health checks or metrics endpoints. Checked before SkipPaths.
+	Filter func(c *fiber.Ctx) bool
+
+	// SkipPaths lists paths that bypass authentication.
+	// Entries are anchored: "/health" matches only that path, while a trailing "*"
+	// matches a prefix (e.g., "/public/*" matches "/public" and everything below it).
+	SkipPaths []string
 }
 
 // HeaderAuth provides Header-based API Key Authentication middleware for Fiber.
@@ -51,12 +67,20 @@ func (ha *HeaderAuth) SetHeaderName(name string) {
 // Middleware returns the Fiber middleware handler for Header-based API Key Authentication.
 func (ha *HeaderAuth) Middleware() fiber.Handler {
 	return keyauth.New(keyauth.Config{
+		// Let exempted paths through without authentication
+		Next: func(c *fiber.Ctx) bool {
+			return shouldSkip(c, ha.config.Filter, ha.config.SkipPaths)
+		},
+
 		// Define where to look for the key: "header:X-API-Key"
 		KeyLookup: "header:" + ha.config.HeaderName,
 
 		// Define the function to validate the extracted key
 		Validator: func(c *fiber.Ctx, key string) (bool, error) {
 			if ha.config.KeyProvider.IsExists(key) {
+				if err := checkIPAllowed(c, ha.config.IPResolver, key); err != nil {
+					return false, err
+				}
 				if ha.config.SuccessHandler != nil {
 					// Call the custom success handler
 					if err := (*ha.config.SuccessHandler)(c, key); err != nil {
@@ -70,7 +94,9 @@ func (ha *HeaderAuth) Middleware() fiber.Handler {
 			return false, keyauth.ErrMissingOrMalformedAPIKey
 		},
 
-		// Optional: Error handler for invalid/missing keys
-		ErrorHandler: ha.config.ErrorHandler,
+		// Optional: Error handler for invalid/missing keys, reporting failures to FailureLogger
+		ErrorHandler: keyauthErrorHandler(ha.config.ErrorHandler, ha.config.FailureLogger, func(c *fiber.Ctx) string {
+			return c.Get(ha.config.HeaderName)
+		}),
 	})
 }
@@ -0,0 +1,207 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// HMACConfig defines the configuration for HMAC webhook-signature verification.
+type HMACConfig struct {
+	// Secret is the shared secret the sender signs the request body with (required)
+	Secret string
+
+	// HeaderName is the header carrying the signature (default: "X-Signature").
+	// The signature may be hex or base64 encoded, optionally prefixed with the
+	// algorithm (e.g., "sha256=3f8a...").
+	HeaderName string
+
+	// Algorithm is the HMAC hash: "sha256", "sha512" or "sha1" (default: "sha256")
+	Algorithm string
+
+	// TimestampHeader is the header carrying the Unix time (seconds) the request was signed at.
+	// When set, the signature covers "<timestamp>.<body>" instead of the body alone,
+	// and requests outside Tolerance are rejected to prevent replays.
+	TimestampHeader string
+
+	// Tolerance is the maximum age (and clock skew) of a signed timestamp (default: 5 minutes).
+	// Only used when TimestampHeader is set.
+	Tolerance time.Duration
+
+	// ErrorHandler is called when verification fails
+	ErrorHandler fiber.ErrorHandler
+
+	// Filter defines a function to skip this middleware when it returns true,
+	// e.g. for health checks or metrics endpoints. Checked before SkipPaths.
+	Filter func(c *fiber.Ctx) bool
+
+	// SkipPaths lists paths that bypass verification.
+	// Entries are anchored: "/health" matches only that path, while a trailing "*"
+	// matches a prefix (e.g., "/public/*" matches "/public" and everything below it).
+	SkipPaths []string
+}
+
+// HMACAuth provides HMAC webhook-signature verification middleware for Fiber.
+type HMACAuth struct {
+	config  HMACConfig
+	newHash func() hash.Hash
+}
+
+var (
+	// ErrHMACMissing indicates that the signature (or timestamp) header is missing or malformed
+	ErrHMACMissing = errors.New("missing or malformed signature")
+
+	// ErrHMACInvalid indicates that the signature does not match the request body
+	ErrHMACInvalid = errors.New("invalid signature")
+
+	// ErrHMACExpired indicates that the signed timestamp is outside the allowed tolerance
+	ErrHMACExpired = errors.New("signature timestamp outside tolerance")
+)
+
+// NewHMACAuth creates a new instance of HMACAuth middleware.
+// It panics when Secret is empty or Algorithm is not supported, so a
+// misconfigured webhook endpoint fails at startup instead of per request.
+//
+// Example:
+//
+//	webhook := auth.NewHMACAuth(auth.HMACConfig{
+//	    Secret:          os.Getenv("PAYMENT_WEBHOOK_SECRET"),
+//	    HeaderName:      "X-Signature",
+//	    TimestampHeader: "X-Timestamp",
+//	})
+//	app.Post("/webhooks/payment", webhook.Middleware(), handlePayment)
+func NewHMACAuth(config HMACConfig) *HMACAuth {
+	if config.Secret == "" {
+		panic("auth: HMACConfig.Secret is required")
+	}
+	if config.HeaderName == "" {
+		config.HeaderName = "X-Signature"
+	}
+	if config.Algorithm == "" {
+		config.Algorithm = "sha256"
+	}
+	config.Algorithm = strings.ToLower(config.Algorithm)
+	if config.Tolerance == 0 {
+		config.Tolerance = 5 * time.Minute
+	}
+
+	newHash := hmacHashFunc(config.Algorithm)
+	if newHash == nil {
+		panic(fmt.Sprintf("auth: unsupported HMAC algorithm: %s", config.Algorithm))
+	}
+	return &HMACAuth{config: config, newHash: newHash}
+}
+
+// hmacHashFunc returns the hash constructor for algorithm, or nil when it is not supported
+func hmacHashFunc(algorithm string) func() hash.Hash {
+	switch algorithm {
+	case "sha256":
+		return sha256.New
+	case "sha512":
+		return sha512.New
+	case "sha1":
+		return sha1.New
+	default:
+		return nil
+	}
+}
+
+// Middleware returns the Fiber middleware handler for HMAC signature verification.
+// Requests with a missing, invalid or expired signature get 401 Unauthorized.
+func (h *HMACAuth) Middleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		// Let exempted paths through without verification
+		if shouldSkip(c, h.config.Filter, h.config.SkipPaths) {
+			return c.Next()
+		}
+
+		if err := h.verify(c); err != nil {
+			if h.config.ErrorHandler != nil {
+				return h.config.ErrorHandler(c, err)
+			}
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error":   "Unauthorized",
+				"message": err.Error(),
+			})
+		}
+
+		return c.Next()
+	}
+}
+
+// verify checks the timestamp (if configured) and the signature of the raw request body
+func (h *HMACAuth) verify(c *fiber.Ctx) error {
+	signature, err := decodeSignature(c.Get(h.config.HeaderName), h.config.Algorithm)
+	if err != nil {
+		return ErrHMACMissing
+	}
+
+	mac := hmac.New(h.newHash, []byte(h.config.Secret))
+	if h.config.TimestampHeader != "" {
+		timestamp := strings.TrimSpace(c.Get(h.config.TimestampHeader))
+		unix, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			return ErrHMACMissing
+		}
+		age := time.Since(time.Unix(unix, 0))
+		if age > h.config.Tolerance || age < -h.config.Tolerance {
+			return ErrHMACExpired
+		}
+		mac.Write([]byte(timestamp + "."))
+	}
+	mac.Write(c.Body())
+
+	if !hmac.Equal(mac.Sum(nil), signature) {
+		return ErrHMACInvalid
+	}
+	return nil
+}
+
+// decodeSignature decodes a hex or base64 signature, stripping an optional "<algorithm>=" prefix
+func decodeSignature(header, algorithm string) ([]byte, error) {
+	sig := strings.TrimSpace(header)
+	sig = strings.TrimPrefix(sig, algorithm+"=")
+	if sig == "" {
+		return nil, ErrHMACMissing
+	}
+
+	if decoded, err := hex.DecodeString(sig); err == nil {
+		return decoded, nil
+	}
+	return base64.StdEncoding.DecodeString(sig)
+}
+
+// SignHMAC computes the signature HMACAuth expects for body, hex encoded. Pass a non-empty
+// timestamp when the middleware is configured with TimestampHeader. Useful for tests and
+// for signing outgoing webhooks.
+//
+// Example:
+//
+//	ts := strconv.FormatInt(time.Now().Unix(), 10)
+//	sig, _ := auth.SignHMAC("sha256", secret, ts, body)
+//	req.Header.Set("X-Signature", sig)
+//	req.Header.Set("X-Timestamp", ts)
+func SignHMAC(algorithm, secret, timestamp string, body []byte) (string, error) {
+	newHash := hmacHashFunc(strings.ToLower(algorithm))
+	if newHash == nil {
+		return "", fmt.Errorf("unsupported HMAC algorithm: %s", algorithm)
+	}
+
+	mac := hmac.New(newHash, []byte(secret))
+	if timestamp != "" {
+		mac.Write([]byte(timestamp + "."))
+	}
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
@@ -0,0 +1,163 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestHMACAuth(t *testing.T) {
+	secret := "whsec_test"
+	body := `{"event":"payment.succeeded","amount":1000}`
+
+	hmacAuth := NewHMACAuth(HMACConfig{Secret: secret})
+	app := fiber.New()
+	app.Post("/webhook", hmacAuth.Middleware(), func(c *fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+
+	validSig, err := SignHMAC("sha256", secret, "", []byte(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wrongSecretSig, _ := SignHMAC("sha256", "other-secret", "", []byte(body))
+
+	tests := []struct {
+		name       string
+		signature  string
+		body       string
+		wantStatus int
+	}{
+		{"valid_hex", validSig, body, fiber.StatusOK},
+		{"valid_with_prefix", "sha256=" + validSig, body, fiber.StatusOK},
+		{"tampered_body", validSig, strings.Replace(body, "1000", "9999", 1), fiber.StatusUnauthorized},
+		{"wrong_secret", wrongSecretSig, body, fiber.StatusUnauthorized},
+		{"missing_signature", "", body, fiber.StatusUnauthorized},
+		{"garbage_signature", "not-a-signature!", body, fiber.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/webhook", strings.NewReader(tt.body))
+			if tt.signature != "" {
+				req.Header.Set("X-Signature", tt.signature)
+			}
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+		})
+	}
+}
+
+func TestHMACAuth_SHA1Base64(t *testing.T) {
+	secret := "legacy-secret"
+	body := "payload"
+
+	app := fiber.New()
+	app.Post("/webhook", NewHMACAuth(HMACConfig{Secret: secret, HeaderName: "X-Hub-Signature", Algorithm: "SHA1"}).Middleware(), func(c *fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write([]byte(body))
+
+	req := httptest.NewRequest("POST", "/webhook", strings.NewReader(body))
+	req.Header.Set("X-Hub-Signature", base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != fiber.StatusOK {
+		t.Errorf("Expected status 200, got %d", resp.StatusCode)
+	}
+}
+
+func TestHMACAuth_Timestamp(t *testing.T) {
+	secret := "whsec_test"
+	body := []byte(`{"event":"refund.created"}`)
+
+	var gotErr error
+	hmacAuth := NewHMACAuth(HMACConfig{
+		Secret:          secret,
+		TimestampHeader: "X-Timestamp",
+		Tolerance:       time.Minute,
+		ErrorHandler: func(c *fiber.Ctx, err error) error {
+			gotErr = err
+			return c.SendStatus(fiber.StatusUnauthorized)
+		},
+	})
+	app := fiber.New()
+	app.Post("/webhook", hmacAuth.Middleware(), func(c *fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+
+	send := func(timestamp, signature string) int {
+		req := httptest.NewRequest("POST", "/webhook", strings.NewReader(string(body)))
+		req.Header.Set("X-Timestamp", timestamp)
+		req.Header.Set("X-Signature", signature)
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.StatusCode
+	}
+
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	sig, _ := SignHMAC("sha256", secret, now, body)
+	if status := send(now, sig); status != fiber.StatusOK {
+		t.Errorf("Expected fresh signature to pass, got %d", status)
+	}
+
+	// The timestamp is part of the signed payload
+	later := strconv.FormatInt(time.Now().Unix()+30, 10)
+	if status := send(later, sig); status != fiber.StatusUnauthorized || gotErr != ErrHMACInvalid {
+		t.Errorf("Expected swapped timestamp to fail with ErrHMACInvalid, got %d %v", status, gotErr)
+	}
+
+	// Replayed signature outside the tolerance
+	old := strconv.FormatInt(time.Now().Add(-10*time.Minute).Unix(), 10)
+	oldSig, _ := SignHMAC("sha256", secret, old, body)
+	if status := send(old, oldSig); status != fiber.StatusUnauthorized || gotErr != ErrHMACExpired {
+		t.Errorf("Expected replayed signature to fail with ErrHMACExpired, got %d %v", status, gotErr)
+	}
+
+	if status := send("", sig); status != fiber.StatusUnauthorized || gotErr != ErrHMACMissing {
+		t.Errorf("Expected missing timestamp to fail with ErrHMACMissing, got %d %v", status, gotErr)
+	}
+}
+
+func TestHMACAuth_InvalidConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config HMACConfig
+	}{
+		{"empty_secret", HMACConfig{}},
+		{"unsupported_algorithm", HMACConfig{Secret: "s", Algorithm: "md5"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("Expected NewHMACAuth to panic")
+				}
+			}()
+			NewHMACAuth(tt.config)
+		})
+	}
+
+	if _, err := SignHMAC("md5", "s", "", nil); err == nil {
+		t.Error("Expected SignHMAC to reject unsupported algorithm")
+	}
+}
@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"errors"
+	"net/netip"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ErrIPNotAllowed indicates that a valid API key was used from an IP address outside its allowlist
+var ErrIPNotAllowed = errors.New("IP address not allowed")
+
+// IPResolver returns the IP allowlist for an API key. Entries may be exact addresses
+// ("203.0.113.7", "2001:db8::1") or CIDR ranges ("10.0.0.0/8"). A nil or empty list means
+// the key is not restricted.
+type IPResolver func(token string) []string
+
+// checkIPAllowed returns ErrIPNotAllowed if the resolver restricts key and the client IP
+// (c.IP(), which honours the app's ProxyHeader) is not in its allowlist.
+func checkIPAllowed(c *fiber.Ctx, resolver IPResolver, key string) error {
+	if resolver == nil {
+		return nil
+	}
+	allowed := resolver(key)
+	if len(allowed) == 0 {
+		return nil
+	}
+	if !ipAllowed(c.IP(), allowed) {
+		return ErrIPNotAllowed
+	}
+	return nil
+}
+
+// ipAllowed reports whether ip matches one of the allowed addresses or CIDR ranges.
+// IPv4-mapped IPv6 addresses are compared as IPv4; malformed entries never match.
+func ipAllowed(ip string, allowed []string) bool {
+	addr, err := netip.ParseAddr(strings.TrimSpace(ip))
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+
+	for _, entry := range allowed {
+		entry = strings.TrimSpace(entry)
+		if strings.Contains(entry, "/") {
+			prefix, err := netip.ParsePrefix(entry)
+			if err == nil && prefix.Contains(addr) {
+				return true
+			}
+			continue
+		}
+
+		other, err := netip.ParseAddr(entry)
+		if err == nil && other.Unmap() == addr {
+			return true
+		}
+	}
+	return false
+}
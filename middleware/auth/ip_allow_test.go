@@ -0,0 +1,134 @@
+package auth
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestIPAllowed(t *testing.T) {
+	allowed := []string{"203.0.113.7", "10.0.0.0/8", "2001:db8::/32", "not-an-ip", "192.168.1.0/33"}
+
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{"203.0.113.7", true},
+		{"203.0.113.8", false},
+		{"10.1.2.3", true},
+		{"11.0.0.1", false},
+		{"::ffff:10.1.2.3", true},
+		{"2001:db8::1", true},
+		{"2001:db9::1", false},
+		{"192.168.1.1", false},
+		{"garbage", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := ipAllowed(tt.ip, allowed); got != tt.want {
+			t.Errorf("ipAllowed(%q) = %v, want %v", tt.ip, got, tt.want)
+		}
+	}
+}
+
+func TestIPResolver_Middlewares(t *testing.T) {
+	keyProvider := NewBaseKeyProvider()
+	keyProvider.Add("office-key")
+	keyProvider.Add("open-key")
+
+	resolver := func(token string) []string {
+		if token == "office-key" {
+			return []string{"203.0.113.7", "10.0.0.0/8"}
+		}
+		return nil
+	}
+
+	middlewares := map[string]struct {
+		handler fiber.Handler
+		target  func(key string) string
+		header  string
+	}{
+		"header": {
+			handler: NewHeaderAuth(HeaderAuthConfig{KeyProvider: keyProvider, IPResolver: resolver}).Middleware(),
+			target:  func(string) string { return "/test" },
+			header:  "X-API-Key",
+		},
+		"querystring": {
+			handler: NewDefaultQueryStringAuth(QueryStringAuthConfig{KeyProvider: keyProvider, ParamName: "access-token", IPResolver: resolver}).Middleware(),
+			target:  func(key string) string { return "/test?access-token=" + key },
+		},
+	}
+
+	for name, mw := range middlewares {
+		t.Run(name, func(t *testing.T) {
+			app := fiber.New(fiber.Config{ProxyHeader: "X-Forwarded-For"})
+			app.Use(mw.handler)
+			app.Get("/test", func(c *fiber.Ctx) error {
+				return c.SendString("ok")
+			})
+
+			tests := []struct {
+				key        string
+				ip         string
+				wantStatus int
+			}{
+				{"office-key", "203.0.113.7", fiber.StatusOK},
+				{"office-key", "10.20.30.40", fiber.StatusOK},
+				{"office-key", "198.51.100.1", fiber.StatusForbidden},
+				{"open-key", "198.51.100.1", fiber.StatusOK},
+				{"unknown-key", "203.0.113.7", fiber.StatusUnauthorized},
+			}
+
+			for _, tt := range tests {
+				req := httptest.NewRequest("GET", mw.target(tt.key), nil)
+				if mw.header != "" {
+					req.Header.Set(mw.header, tt.key)
+				}
+				req.Header.Set("X-Forwarded-For", tt.ip)
+
+				resp, err := app.Test(req)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if resp.StatusCode != tt.wantStatus {
+					t.Errorf("%s from %s: expected status %d, got %d", tt.key, tt.ip, tt.wantStatus, resp.StatusCode)
+				}
+			}
+		})
+	}
+}
+
+func TestIPResolver_CustomErrorHandler(t *testing.T) {
+	keyProvider := NewBaseKeyProvider()
+	keyProvider.Add("office-key")
+
+	var gotErr error
+	headerAuth := NewHeaderAuth(HeaderAuthConfig{
+		KeyProvider: keyProvider,
+		IPResolver:  func(string) []string { return []string{"203.0.113.7"} },
+		ErrorHandler: func(c *fiber.Ctx, err error) error {
+			gotErr = err
+			return c.SendStatus(fiber.StatusTeapot)
+		},
+	})
+
+	app := fiber.New(fiber.Config{ProxyHeader: "X-Forwarded-For"})
+	app.Use(headerAuth.Middleware())
+
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.Header.Set("X-API-Key", "office-key")
+	req.Header.Set("X-Forwarded-For", "198.51.100.1")
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != fiber.StatusTeapot {
+		t.Errorf("Expected custom error handler status, got %d", resp.StatusCode)
+	}
+	if !errors.Is(gotErr, ErrIPNotAllowed) {
+		t.Errorf("Expected ErrIPNotAllowed, got %v", gotErr)
+	}
+}
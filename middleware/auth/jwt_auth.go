@@ -2,28 +2,47 @@ package auth
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 // JWTConfig defines the configuration for JWT middleware.
 type JWTConfig struct {
-	// SecretKey is used to sign and validate JWT tokens
+	// SecretKey is used to sign and validate JWT tokens with HMAC methods (HS256, HS384, HS512)
 	SecretKey string
 
-	// SigningMethod defines the signing method (default: HS256)
+	// Keys holds additional HMAC secrets keyed by key ID ("kid" header), so old and new keys
+	// stay valid while rotating. Tokens without a "kid" header are validated with SecretKey.
+	Keys map[string]string
+
+	// SigningMethod defines the signing method (default: HS256).
+	// RSA (RS*, PS*) and ECDSA (ES*) methods use PublicKey/PrivateKey instead of SecretKey.
 	SigningMethod string
 
+	// PublicKey is the PEM-encoded RSA or ECDSA public key used to validate tokens
+	// signed with an asymmetric method. If empty, it is derived from PrivateKey.
+	PublicKey string
+
+	// PrivateKey is the PEM-encoded RSA or ECDSA private key used to sign tokens
+	// with an asymmetric method. Only needed to generate tokens.
+	PrivateKey string
+
 	// ExpirationTime defines the token expiration duration
 	ExpirationTime time.Duration
 
 	// Issuer is the issuer of the token
 	Issuer string
 
+	// Leeway is the clock-skew tolerance applied when checking "exp", "nbf" and "iat",
+	// e.g. 5*time.Second when app servers drift from the issuer (default: 0, no tolerance)
+	Leeway time.Duration
+
 	// TokenLookup defines where to look for the JWT token
 	// Format: "<source>:<name>"
 	// Possible values:
@@ -40,17 +59,42 @@ type JWTConfig struct {
 	// Default: "user"
 	ContextKey string
 
+	// RequiredClaims lists claims that must be present and non-empty (e.g., "user_id", "tenant_id").
+	// Tokens missing any of them are rejected with ErrJWTInvalid even if the signature is valid.
+	RequiredClaims []string
+
+	// Revoker rejects tokens whose "jti" claim has been revoked (e.g. on logout).
+	// Use NewMemoryRevoker for a single instance or NewRedisRevoker to share revocations.
+	Revoker Revoker
+
 	// SuccessHandler is called after successful JWT validation
 	SuccessHandler func(c *fiber.Ctx, claims jwt.MapClaims) error
 
 	// ErrorHandler is called when JWT validation fails
 	ErrorHandler fiber.ErrorHandler
+
+	// FailureLogger is called when authentication fails, with the token masked by MaskKey.
+	FailureLogger FailureLogger
+
+	// Filter defines a function to skip this middleware when it returns true,
+	// e.g. for health checks or metrics endpoints. Checked before SkipPaths.
+	Filter func(c *fiber.Ctx) bool
+
+	// SkipPaths lists paths that bypass authentication.
+	// Entries are anchored: "/health" matches only that path, while a trailing "*"
+	// matches a prefix (e.g., "/public/*" matches "/public" and everything below it).
+	SkipPaths []string
 }
 
 // JWTAuth provides JWT Authentication middleware for Fiber.
 type JWTAuth struct {
 	config JWTConfig
 	mu     sync.RWMutex
+
+	// Parsed asymmetric keys; keyErr holds the PEM parsing error, if any
+	publicKey  interface{}
+	privateKey interface{}
+	keyErr     error
 }
 
 var (
@@ -77,17 +121,85 @@ func NewJWTAuth(config JWTConfig) *JWTAuth {
 		config.ContextKey = "claims"
 	}
 
-	return &JWTAuth{
+	// Copy keys so later changes to the caller's map do not race with validation
+	keys := make(map[string]string, len(config.Keys))
+	for kid, secret := range config.Keys {
+		keys[kid] = secret
+	}
+	config.Keys = keys
+
+	j := &JWTAuth{
 		config: config,
 	}
+	j.publicKey, j.privateKey, j.keyErr = parseAsymmetricKeys(config)
+
+	return j
+}
+
+// isAsymmetricMethod reports whether the signing method uses an RSA or ECDSA key pair
+func isAsymmetricMethod(method string) bool {
+	return strings.HasPrefix(method, "RS") || strings.HasPrefix(method, "PS") || strings.HasPrefix(method, "ES")
+}
+
+// parseAsymmetricKeys parses the PEM keys for RSA and ECDSA signing methods.
+// HMAC methods need no parsing and return nil keys.
+func parseAsymmetricKeys(config JWTConfig) (publicKey interface{}, privateKey interface{}, err error) {
+	method := config.SigningMethod
+	if !isAsymmetricMethod(method) {
+		return nil, nil, nil
+	}
+
+	if config.PrivateKey != "" {
+		if strings.HasPrefix(method, "ES") {
+			key, err := jwt.ParseECPrivateKeyFromPEM([]byte(config.PrivateKey))
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid ECDSA private key: %w", err)
+			}
+			privateKey, publicKey = key, &key.PublicKey
+		} else {
+			key, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(config.PrivateKey))
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid RSA private key: %w", err)
+			}
+			privateKey, publicKey = key, &key.PublicKey
+		}
+	}
+
+	if config.PublicKey != "" {
+		if strings.HasPrefix(method, "ES") {
+			key, err := jwt.ParseECPublicKeyFromPEM([]byte(config.PublicKey))
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid ECDSA public key: %w", err)
+			}
+			publicKey = key
+		} else {
+			key, err := jwt.ParseRSAPublicKeyFromPEM([]byte(config.PublicKey))
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid RSA public key: %w", err)
+			}
+			publicKey = key
+		}
+	}
+
+	if publicKey == nil {
+		return nil, nil, fmt.Errorf("signing method %s requires a PublicKey or PrivateKey", method)
+	}
+
+	return publicKey, privateKey, nil
 }
 
 // Middleware returns the Fiber middleware handler for JWT Authentication.
 func (j *JWTAuth) Middleware() fiber.Handler {
 	return func(c *fiber.Ctx) error {
+		// Let exempted paths through without authentication
+		if shouldSkip(c, j.config.Filter, j.config.SkipPaths) {
+			return c.Next()
+		}
+
 		// Extract token from request
 		tokenString, err := j.extractToken(c)
 		if err != nil {
+			j.logFailure(c, "", err)
 			if j.config.ErrorHandler != nil {
 				return j.config.ErrorHandler(c, err)
 			}
@@ -97,12 +209,10 @@ func (j *JWTAuth) Middleware() fiber.Handler {
 			})
 		}
 
-		// Parse and validate token with read lock
-		j.mu.RLock()
-		token, err := j.parseToken(tokenString)
-		j.mu.RUnlock()
-
-		if err != nil || !token.Valid {
+		// Parse and validate token
+		claims, err := j.ParseAndValidate(tokenString)
+		if err != nil {
+			j.logFailure(c, tokenString, err)
 			if j.config.ErrorHandler != nil {
 				return j.config.ErrorHandler(c, ErrJWTInvalid)
 			}
@@ -112,21 +222,15 @@ func (j *JWTAuth) Middleware() fiber.Handler {
 			})
 		}
 
-		// Extract claims
-		var claims jwt.MapClaims
-		if mapClaims, ok := token.Claims.(jwt.MapClaims); ok {
-			claims = mapClaims
-		} else {
-			claims = jwt.MapClaims{}
-		}
-
 		// Store claims in context
 		c.Locals(j.config.ContextKey, claims)
+		c.Locals(claimsKeyLocal{}, j.config.ContextKey)
 		c.Locals("user_token", claims["ses"])
 
 		// Call success handler if provided
 		if j.config.SuccessHandler != nil {
 			if err := j.config.SuccessHandler(c, claims); err != nil {
+				j.logFailure(c, tokenString, err)
 				if j.config.ErrorHandler != nil {
 					return j.config.ErrorHandler(c, err)
 				}
@@ -141,6 +245,13 @@ func (j *JWTAuth) Middleware() fiber.Handler {
 	}
 }
 
+// logFailure reports a failed authentication to the FailureLogger, masking the token
+func (j *JWTAuth) logFailure(c *fiber.Ctx, tokenString string, err error) {
+	if j.config.FailureLogger != nil {
+		j.config.FailureLogger(c, MaskKey(tokenString), err)
+	}
+}
+
 // extractToken extracts JWT token from request based on TokenLookup configuration
 func (j *JWTAuth) extractToken(c *fiber.Ctx) (string, error) {
 	parts := strings.Split(j.config.TokenLookup, ":")
@@ -190,23 +301,123 @@ func (j *JWTAuth) extractToken(c *fiber.Ctx) (string, error) {
 	return tokenString, nil
 }
 
-// parseToken parses and validates the JWT token
+// ParseAndValidate parses a raw token string and runs the same signature, expiry,
+// claims and RequiredClaims validation as the middleware. It is intended for callers
+// without an HTTP request, such as background jobs, and always uses the current secret key.
+//
+// The returned error wraps ErrJWTInvalid and the underlying jwt error, so both
+// errors.Is(err, ErrJWTInvalid) and errors.Is(err, jwt.ErrTokenExpired) work.
+//
+// Refresh tokens issued by GenerateTokenPair are rejected; exchange them with RefreshToken.
+func (j *JWTAuth) ParseAndValidate(tokenString string) (jwt.MapClaims, error) {
+	claims, err := j.validateToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if isRefreshToken(claims) {
+		return nil, fmt.Errorf("%w: refresh token cannot be used for authentication", ErrJWTInvalid)
+	}
+	return claims, nil
+}
+
+// validateToken runs signature, expiry, RequiredClaims and revocation checks
+// shared by access and refresh tokens.
+func (j *JWTAuth) validateToken(tokenString string) (jwt.MapClaims, error) {
+	// Parse and validate token with read lock
+	j.mu.RLock()
+	token, err := j.parseToken(tokenString)
+	j.mu.RUnlock()
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrJWTInvalid, err)
+	}
+	if !token.Valid {
+		return nil, ErrJWTInvalid
+	}
+
+	// Extract claims
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		claims = jwt.MapClaims{}
+	}
+
+	// Reject signed tokens that lack required claims
+	for _, name := range j.config.RequiredClaims {
+		if isEmptyClaim(claims[name]) {
+			return nil, fmt.Errorf("%w: missing required claim %q", ErrJWTInvalid, name)
+		}
+	}
+
+	// Reject tokens that were revoked before they expired
+	if j.config.Revoker != nil {
+		if jti, ok := claims["jti"].(string); ok && jti != "" && j.config.Revoker.IsRevoked(jti) {
+			return nil, fmt.Errorf("%w: %w", ErrJWTInvalid, ErrJWTRevoked)
+		}
+	}
+
+	return claims, nil
+}
+
+// isEmptyClaim reports whether a claim value is missing, null, an empty string, or an empty array/object
+func isEmptyClaim(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(v) == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// parseToken parses and validates the JWT token.
+// The token's "alg" header must match the configured SigningMethod, so an attacker cannot
+// switch an RS256 deployment to HS256 and sign tokens with the public key as HMAC secret.
+// Callers must hold the read lock.
 func (j *JWTAuth) parseToken(tokenString string) (*jwt.Token, error) {
 	// Determine claims type
 	var claims jwt.Claims = jwt.MapClaims{}
+	signingMethod := j.config.SigningMethod
 
 	// Parse token
 	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
 		// Validate signing method
-		if token.Method.Alg() != j.config.SigningMethod {
-			return nil, errors.New("unexpected signing method")
+		if token.Method.Alg() != signingMethod {
+			return nil, fmt.Errorf("unexpected signing method: %s", token.Method.Alg())
 		}
-		return []byte(j.GetSecretKey()), nil
-	})
+		if isAsymmetricMethod(signingMethod) {
+			if j.keyErr != nil {
+				return nil, j.keyErr
+			}
+			return j.publicKey, nil
+		}
+		return j.hmacKey(token.Header["kid"])
+	}, jwt.WithValidMethods([]string{signingMethod}), jwt.WithLeeway(j.config.Leeway))
 
 	return token, err
 }
 
+// hmacKey returns the HMAC secret for the "kid" header value, or SecretKey when the
+// token has no key ID. Callers must hold the read lock.
+func (j *JWTAuth) hmacKey(kid interface{}) ([]byte, error) {
+	if kid == nil {
+		return []byte(j.config.SecretKey), nil
+	}
+
+	id, ok := kid.(string)
+	if !ok {
+		return nil, errors.New("invalid kid header")
+	}
+	secret, ok := j.config.Keys[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown kid: %s", id)
+	}
+	return []byte(secret), nil
+}
+
 // GetSecretKey returns the secret key used for JWT signing
 func (j *JWTAuth) GetSecretKey() string {
 	j.mu.RLock()
@@ -221,6 +432,21 @@ func (j *JWTAuth) SetSecretKey(secretKey string) {
 	j.config.SecretKey = secretKey
 }
 
+// SetKey adds or replaces the HMAC secret for a key ID, e.g. when a new key is rotated in
+func (j *JWTAuth) SetKey(kid string, secret string) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.config.Keys[kid] = secret
+}
+
+// RemoveKey removes the HMAC secret for a key ID once the rotation overlap window is over.
+// Tokens signed with that key are rejected afterwards.
+func (j *JWTAuth) RemoveKey(kid string) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	delete(j.config.Keys, kid)
+}
+
 // GetSigningMethod returns the signing method
 func (j *JWTAuth) GetSigningMethod() string {
 	j.mu.RLock()
@@ -235,24 +461,94 @@ func (j *JWTAuth) GetContextKey() string {
 	return j.config.ContextKey
 }
 
-// GenerateToken generates a new JWT token for the given user token
-func (j *JWTAuth) GenerateToken(userToken string) (string, error) {
-	expirationTime := time.Now().Add(time.Duration(j.config.ExpirationTime))
+// GenerateToken signs a new token with the configured SigningMethod and key (SecretKey for
+// HMAC methods, PrivateKey for RSA/ECDSA), so issued tokens always validate against the same middleware.
+// It sets "exp" (now + ttl), "iat" and "nbf" (now), a random "jti" so the token can be revoked,
+// and "iss" from the configured Issuer; "jti" and "iss" are kept if the claims already
+// contain them. The given claims map is not modified.
+//
+// An optional key ID sets the "kid" header; for HMAC methods the token is then signed
+// with the matching secret from Keys instead of SecretKey.
+//
+// Example:
+//
+//	token, err := jwtAuth.GenerateToken(jwt.MapClaims{
+//	    "sub":  user.ID,
+//	    "role": user.Role,
+//	}, 15*time.Minute)
+//
+//	// Sign with the current rotation key
+//	token, err := jwtAuth.GenerateToken(claims, time.Hour, "2025-w47")
+func (j *JWTAuth) GenerateToken(claims jwt.MapClaims, ttl time.Duration, kid ...string) (string, error) {
+	keyID := ""
+	if len(kid) > 0 {
+		keyID = kid[0]
+	}
+	now := time.Now()
+	return j.signToken(claims, now, now.Add(ttl), keyID)
+}
+
+// signToken signs claims with "iat"/"nbf" set to now and "exp" set to expiresAt
+func (j *JWTAuth) signToken(claims jwt.MapClaims, now, expiresAt time.Time, keyID string) (string, error) {
 
-	claims := jwt.MapClaims{
-		"ses": userToken,
-		"exp": jwt.NewNumericDate(expirationTime),
-		"iat": jwt.NewNumericDate(time.Now()),
-		"iss": j.config.Issuer,
+	j.mu.RLock()
+	var signingKey interface{} = []byte(j.config.SecretKey)
+	if keyID != "" && !isAsymmetricMethod(j.config.SigningMethod) {
+		secret, ok := j.config.Keys[keyID]
+		if !ok {
+			j.mu.RUnlock()
+			return "", fmt.Errorf("unknown kid: %s", keyID)
+		}
+		signingKey = []byte(secret)
+	}
+	signingMethod := j.config.SigningMethod
+	issuer := j.config.Issuer
+	privateKey, keyErr := j.privateKey, j.keyErr
+	j.mu.RUnlock()
+
+	method := jwt.GetSigningMethod(signingMethod)
+	if method == nil {
+		return "", fmt.Errorf("unsupported signing method: %s", signingMethod)
+	}
+	if isAsymmetricMethod(signingMethod) {
+		if keyErr != nil {
+			return "", keyErr
+		}
+		if privateKey == nil {
+			return "", fmt.Errorf("signing method %s requires a PrivateKey to generate tokens", signingMethod)
+		}
+		signingKey = privateKey
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(j.GetSecretKey()))
-	if err != nil {
-		return "", err
+	tokenClaims := make(jwt.MapClaims, len(claims)+5)
+	for k, v := range claims {
+		tokenClaims[k] = v
+	}
+	tokenClaims["exp"] = jwt.NewNumericDate(expiresAt)
+	tokenClaims["iat"] = jwt.NewNumericDate(now)
+	tokenClaims["nbf"] = jwt.NewNumericDate(now)
+	if _, ok := tokenClaims["jti"]; !ok {
+		tokenClaims["jti"] = uuid.NewString()
+	}
+	if _, ok := tokenClaims["iss"]; !ok && issuer != "" {
+		tokenClaims["iss"] = issuer
 	}
 
-	return tokenString, nil
+	token := jwt.NewWithClaims(method, tokenClaims)
+	if keyID != "" {
+		token.Header["kid"] = keyID
+	}
+	return token.SignedString(signingKey)
+}
+
+// GenerateSessionToken generates a new JWT token carrying the given user token in the "ses" claim,
+// which the middleware exposes as the "user_token" local. It expires after the configured ExpirationTime.
+func (j *JWTAuth) GenerateSessionToken(userToken string) (string, error) {
+	j.mu.RLock()
+	ttl := j.config.ExpirationTime
+	j.mu.RUnlock()
+
+	return j.GenerateToken(jwt.MapClaims{"ses": userToken}, ttl)
 }
 
 func (j *JWTAuth) SetSuccessHandler(handler func(c *fiber.Ctx, claims jwt.MapClaims) error) {
@@ -1,6 +1,13 @@
 package auth
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
 	"io"
 	"net/http/httptest"
 	"testing"
@@ -588,3 +595,426 @@ func TestSetSecretKeyConcurrent(t *testing.T) {
 	// Test should not panic or race
 	t.Log("Concurrent access test passed")
 }
+
+func TestJWTAuth_ParseAndValidate(t *testing.T) {
+	secretKey := "test-secret-key"
+	jwtAuth := NewJWTAuth(JWTConfig{SecretKey: secretKey})
+
+	t.Run("valid_token", func(t *testing.T) {
+		token := generateTestToken(secretKey, jwt.MapClaims{
+			"user_id": "123",
+			"exp":     time.Now().Add(time.Hour).Unix(),
+		}, "HS256")
+
+		claims, err := jwtAuth.ParseAndValidate(token)
+		if err != nil {
+			t.Fatalf("Expected valid token, got error: %v", err)
+		}
+		if claims["user_id"] != "123" {
+			t.Errorf("Expected user_id '123', got %v", claims["user_id"])
+		}
+	})
+
+	t.Run("expired_token", func(t *testing.T) {
+		token := generateTestToken(secretKey, jwt.MapClaims{
+			"user_id": "123",
+			"exp":     time.Now().Add(-time.Hour).Unix(),
+		}, "HS256")
+
+		claims, err := jwtAuth.ParseAndValidate(token)
+		if !errors.Is(err, ErrJWTInvalid) {
+			t.Errorf("Expected ErrJWTInvalid, got %v", err)
+		}
+		if !errors.Is(err, jwt.ErrTokenExpired) {
+			t.Errorf("Expected jwt.ErrTokenExpired, got %v", err)
+		}
+		if claims != nil {
+			t.Errorf("Expected nil claims, got %v", claims)
+		}
+	})
+
+	t.Run("wrong_secret", func(t *testing.T) {
+		token := generateTestToken("wrong-secret-key", jwt.MapClaims{
+			"user_id": "123",
+			"exp":     time.Now().Add(time.Hour).Unix(),
+		}, "HS256")
+
+		if _, err := jwtAuth.ParseAndValidate(token); !errors.Is(err, ErrJWTInvalid) {
+			t.Errorf("Expected ErrJWTInvalid, got %v", err)
+		}
+	})
+
+	t.Run("respects_secret_change", func(t *testing.T) {
+		auth := NewJWTAuth(JWTConfig{SecretKey: "old-secret"})
+		token := generateTestToken("new-secret", jwt.MapClaims{
+			"exp": time.Now().Add(time.Hour).Unix(),
+		}, "HS256")
+
+		if _, err := auth.ParseAndValidate(token); err == nil {
+			t.Fatal("Expected token signed with new secret to fail before rotation")
+		}
+		auth.SetSecretKey("new-secret")
+		if _, err := auth.ParseAndValidate(token); err != nil {
+			t.Errorf("Expected token to be valid after rotation, got %v", err)
+		}
+	})
+}
+
+func TestJWTAuth_GenerateToken(t *testing.T) {
+	t.Run("round_trip", func(t *testing.T) {
+		jwtAuth := NewJWTAuth(JWTConfig{
+			SecretKey:     "test-secret-key",
+			SigningMethod: "HS512",
+			Issuer:        "go-pkg",
+		})
+
+		input := jwt.MapClaims{"sub": "user-1"}
+		token, err := jwtAuth.GenerateToken(input, time.Hour)
+		if err != nil {
+			t.Fatalf("Failed to generate token: %v", err)
+		}
+		if len(input) != 1 {
+			t.Errorf("Expected input claims to be unmodified, got %v", input)
+		}
+
+		claims, err := jwtAuth.ParseAndValidate(token)
+		if err != nil {
+			t.Fatalf("Expected generated token to validate, got %v", err)
+		}
+		if claims["sub"] != "user-1" {
+			t.Errorf("Expected sub 'user-1', got %v", claims["sub"])
+		}
+		if claims["iss"] != "go-pkg" {
+			t.Errorf("Expected iss 'go-pkg', got %v", claims["iss"])
+		}
+		for _, key := range []string{"exp", "iat", "nbf"} {
+			if _, ok := claims[key]; !ok {
+				t.Errorf("Expected %s claim to be set", key)
+			}
+		}
+
+		exp, _ := ClaimTime(claims, "exp")
+		if d := time.Until(exp); d < 59*time.Minute || d > time.Hour {
+			t.Errorf("Expected exp about one hour from now, got %v", d)
+		}
+	})
+
+	t.Run("validates_in_middleware", func(t *testing.T) {
+		jwtAuth := NewJWTAuth(JWTConfig{SecretKey: "test-secret-key", ExpirationTime: time.Hour})
+		token, _ := jwtAuth.GenerateSessionToken("session-123")
+
+		app := fiber.New()
+		app.Use(jwtAuth.Middleware())
+		app.Get("/test", func(c *fiber.Ctx) error {
+			return c.SendString(c.Locals("user_token").(string))
+		})
+
+		req := httptest.NewRequest("GET", "/test", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode != fiber.StatusOK || string(body) != "session-123" {
+			t.Errorf("Expected 200 with session token, got %d %q", resp.StatusCode, body)
+		}
+	})
+
+	t.Run("expired_ttl", func(t *testing.T) {
+		jwtAuth := NewJWTAuth(JWTConfig{SecretKey: "test-secret-key"})
+		token, _ := jwtAuth.GenerateToken(jwt.MapClaims{}, -time.Minute)
+
+		if _, err := jwtAuth.ParseAndValidate(token); !errors.Is(err, jwt.ErrTokenExpired) {
+			t.Errorf("Expected expired token, got %v", err)
+		}
+	})
+}
+
+// generateTestKeyPair returns PEM-encoded private and public keys for the signing method family
+func generateTestKeyPair(t *testing.T, method string) (privatePEM string, publicPEM string) {
+	t.Helper()
+
+	var privateDER []byte
+	var publicKey interface{}
+	var blockType string
+	switch method[:2] {
+	case "ES":
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		privateDER, err = x509.MarshalECPrivateKey(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		publicKey, blockType = &key.PublicKey, "EC PRIVATE KEY"
+	default:
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatal(err)
+		}
+		privateDER = x509.MarshalPKCS1PrivateKey(key)
+		publicKey, blockType = &key.PublicKey, "RSA PRIVATE KEY"
+	}
+
+	publicDER, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	privatePEM = string(pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: privateDER}))
+	publicPEM = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER}))
+	return privatePEM, publicPEM
+}
+
+func TestJWTAuth_AsymmetricSigning(t *testing.T) {
+	for _, method := range []string{"RS256", "ES256"} {
+		t.Run(method, func(t *testing.T) {
+			privatePEM, publicPEM := generateTestKeyPair(t, method)
+
+			issuer := NewJWTAuth(JWTConfig{SigningMethod: method, PrivateKey: privatePEM})
+			verifier := NewJWTAuth(JWTConfig{SigningMethod: method, PublicKey: publicPEM})
+
+			token, err := issuer.GenerateToken(jwt.MapClaims{"sub": "user-1"}, time.Hour)
+			if err != nil {
+				t.Fatalf("Failed to generate token: %v", err)
+			}
+
+			claims, err := verifier.ParseAndValidate(token)
+			if err != nil {
+				t.Fatalf("Expected token to validate with public key, got %v", err)
+			}
+			if claims["sub"] != "user-1" {
+				t.Errorf("Expected sub 'user-1', got %v", claims["sub"])
+			}
+
+			// The private key alone is enough to validate too
+			if _, err := issuer.ParseAndValidate(token); err != nil {
+				t.Errorf("Expected token to validate with derived public key, got %v", err)
+			}
+
+			// A verifier without a private key cannot issue tokens
+			if _, err := verifier.GenerateToken(jwt.MapClaims{}, time.Hour); err == nil {
+				t.Error("Expected GenerateToken to fail without a private key")
+			}
+		})
+	}
+
+	t.Run("wrong_key", func(t *testing.T) {
+		privatePEM, _ := generateTestKeyPair(t, "RS256")
+		_, otherPublicPEM := generateTestKeyPair(t, "RS256")
+
+		token, _ := NewJWTAuth(JWTConfig{SigningMethod: "RS256", PrivateKey: privatePEM}).
+			GenerateToken(jwt.MapClaims{}, time.Hour)
+		verifier := NewJWTAuth(JWTConfig{SigningMethod: "RS256", PublicKey: otherPublicPEM})
+
+		if _, err := verifier.ParseAndValidate(token); !errors.Is(err, ErrJWTInvalid) {
+			t.Errorf("Expected ErrJWTInvalid, got %v", err)
+		}
+	})
+
+	t.Run("invalid_pem", func(t *testing.T) {
+		verifier := NewJWTAuth(JWTConfig{SigningMethod: "RS256", PublicKey: "not a key"})
+		token := generateTestToken("secret", jwt.MapClaims{"exp": time.Now().Add(time.Hour).Unix()}, "HS256")
+
+		if _, err := verifier.ParseAndValidate(token); !errors.Is(err, ErrJWTInvalid) {
+			t.Errorf("Expected ErrJWTInvalid, got %v", err)
+		}
+	})
+}
+
+func TestJWTAuth_AlgorithmConfusion(t *testing.T) {
+	_, publicPEM := generateTestKeyPair(t, "RS256")
+	verifier := NewJWTAuth(JWTConfig{SigningMethod: "RS256", PublicKey: publicPEM})
+
+	// Classic attack: sign an HS256 token using the public key as the HMAC secret
+	forged := generateTestToken(publicPEM, jwt.MapClaims{
+		"sub": "admin",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}, "HS256")
+
+	if _, err := verifier.ParseAndValidate(forged); !errors.Is(err, ErrJWTInvalid) {
+		t.Errorf("Expected forged HS256 token to be rejected, got %v", err)
+	}
+
+	// The reverse: an HS256 deployment must reject tokens with another alg
+	hmacAuth := NewJWTAuth(JWTConfig{SecretKey: "secret"})
+	token := generateTestToken("secret", jwt.MapClaims{"exp": time.Now().Add(time.Hour).Unix()}, "HS512")
+	if _, err := hmacAuth.ParseAndValidate(token); !errors.Is(err, ErrJWTInvalid) {
+		t.Errorf("Expected HS512 token to be rejected by HS256 config, got %v", err)
+	}
+}
+
+func TestJWTAuth_KeyRotation(t *testing.T) {
+	jwtAuth := NewJWTAuth(JWTConfig{
+		SecretKey: "default-secret",
+		Keys: map[string]string{
+			"2025-w46": "old-secret",
+			"2025-w47": "new-secret",
+		},
+	})
+
+	t.Run("both_keys_valid", func(t *testing.T) {
+		for _, kid := range []string{"2025-w46", "2025-w47"} {
+			token, err := jwtAuth.GenerateToken(jwt.MapClaims{"sub": "user-1"}, time.Hour, kid)
+			if err != nil {
+				t.Fatalf("Failed to generate token for kid %s: %v", kid, err)
+			}
+
+			parsed, _, _ := jwt.NewParser().ParseUnverified(token, jwt.MapClaims{})
+			if parsed.Header["kid"] != kid {
+				t.Errorf("Expected kid header '%s', got %v", kid, parsed.Header["kid"])
+			}
+			if _, err := jwtAuth.ParseAndValidate(token); err != nil {
+				t.Errorf("Expected token with kid %s to validate, got %v", kid, err)
+			}
+		}
+	})
+
+	t.Run("no_kid_uses_secret_key", func(t *testing.T) {
+		token := generateTestToken("default-secret", jwt.MapClaims{
+			"exp": time.Now().Add(time.Hour).Unix(),
+		}, "HS256")
+
+		if _, err := jwtAuth.ParseAndValidate(token); err != nil {
+			t.Errorf("Expected token without kid to validate with SecretKey, got %v", err)
+		}
+	})
+
+	t.Run("kid_signed_with_wrong_key", func(t *testing.T) {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"exp": time.Now().Add(time.Hour).Unix(),
+		})
+		token.Header["kid"] = "2025-w47"
+		tokenString, _ := token.SignedString([]byte("old-secret"))
+
+		if _, err := jwtAuth.ParseAndValidate(tokenString); !errors.Is(err, ErrJWTInvalid) {
+			t.Errorf("Expected ErrJWTInvalid, got %v", err)
+		}
+	})
+
+	t.Run("unknown_kid", func(t *testing.T) {
+		if _, err := jwtAuth.GenerateToken(jwt.MapClaims{}, time.Hour, "missing"); err == nil {
+			t.Error("Expected GenerateToken to fail for unknown kid")
+		}
+
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"exp": time.Now().Add(time.Hour).Unix(),
+		})
+		token.Header["kid"] = "missing"
+		tokenString, _ := token.SignedString([]byte("default-secret"))
+
+		if _, err := jwtAuth.ParseAndValidate(tokenString); !errors.Is(err, ErrJWTInvalid) {
+			t.Errorf("Expected ErrJWTInvalid for unknown kid, got %v", err)
+		}
+	})
+
+	t.Run("remove_key", func(t *testing.T) {
+		auth := NewJWTAuth(JWTConfig{SecretKey: "default-secret"})
+		auth.SetKey("k1", "rotating-secret")
+
+		token, err := auth.GenerateToken(jwt.MapClaims{}, time.Hour, "k1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := auth.ParseAndValidate(token); err != nil {
+			t.Fatalf("Expected token to validate, got %v", err)
+		}
+
+		auth.RemoveKey("k1")
+		if _, err := auth.ParseAndValidate(token); !errors.Is(err, ErrJWTInvalid) {
+			t.Errorf("Expected token to be rejected after key removal, got %v", err)
+		}
+	})
+}
+
+func TestJWTAuth_RequiredClaims(t *testing.T) {
+	secretKey := "test-secret-key"
+	jwtAuth := NewJWTAuth(JWTConfig{
+		SecretKey:      secretKey,
+		RequiredClaims: []string{"user_id", "tenant_id"},
+	})
+
+	app := fiber.New()
+	app.Use(jwtAuth.Middleware())
+	app.Get("/test", func(c *fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+
+	tests := []struct {
+		name       string
+		claims     jwt.MapClaims
+		wantStatus int
+	}{
+		{"all_present", jwt.MapClaims{"user_id": "123", "tenant_id": "acme"}, fiber.StatusOK},
+		{"missing_claim", jwt.MapClaims{"user_id": "123"}, fiber.StatusUnauthorized},
+		{"empty_claim", jwt.MapClaims{"user_id": "123", "tenant_id": ""}, fiber.StatusUnauthorized},
+		{"null_claim", jwt.MapClaims{"user_id": nil, "tenant_id": "acme"}, fiber.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.claims["exp"] = time.Now().Add(time.Hour).Unix()
+			token := generateTestToken(secretKey, tt.claims, "HS256")
+
+			req := httptest.NewRequest("GET", "/test", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+		})
+	}
+
+	t.Run("parse_and_validate", func(t *testing.T) {
+		token := generateTestToken(secretKey, jwt.MapClaims{
+			"user_id": "123",
+			"exp":     time.Now().Add(time.Hour).Unix(),
+		}, "HS256")
+
+		if _, err := jwtAuth.ParseAndValidate(token); !errors.Is(err, ErrJWTInvalid) {
+			t.Errorf("Expected ErrJWTInvalid, got %v", err)
+		}
+	})
+}
+
+func TestJWTAuth_Leeway(t *testing.T) {
+	secretKey := "test-secret-key"
+	expired := generateTestToken(secretKey, jwt.MapClaims{"sub": "user-1", "exp": time.Now().Add(-3 * time.Second).Unix()}, "HS256")
+	notYetValid := generateTestToken(secretKey, jwt.MapClaims{
+		"sub": "user-1",
+		"nbf": time.Now().Add(3 * time.Second).Unix(),
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}, "HS256")
+
+	t.Run("no_leeway", func(t *testing.T) {
+		jwtAuth := NewJWTAuth(JWTConfig{SecretKey: secretKey})
+		if _, err := jwtAuth.ParseAndValidate(expired); !errors.Is(err, jwt.ErrTokenExpired) {
+			t.Errorf("Expected ErrTokenExpired, got %v", err)
+		}
+		if _, err := jwtAuth.ParseAndValidate(notYetValid); !errors.Is(err, jwt.ErrTokenNotValidYet) {
+			t.Errorf("Expected ErrTokenNotValidYet, got %v", err)
+		}
+	})
+
+	t.Run("within_leeway", func(t *testing.T) {
+		jwtAuth := NewJWTAuth(JWTConfig{SecretKey: secretKey, Leeway: 10 * time.Second})
+		if _, err := jwtAuth.ParseAndValidate(expired); err != nil {
+			t.Errorf("Expected expired token within leeway to be accepted, got %v", err)
+		}
+		if _, err := jwtAuth.ParseAndValidate(notYetValid); err != nil {
+			t.Errorf("Expected not-yet-valid token within leeway to be accepted, got %v", err)
+		}
+	})
+
+	t.Run("beyond_leeway", func(t *testing.T) {
+		jwtAuth := NewJWTAuth(JWTConfig{SecretKey: secretKey, Leeway: time.Second})
+		if _, err := jwtAuth.ParseAndValidate(expired); !errors.Is(err, jwt.ErrTokenExpired) {
+			t.Errorf("Expected ErrTokenExpired, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,166 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// claimsKeyLocal is the context local under which Middleware records its ContextKey,
+// so GetClaims and BindClaims find the claims whatever key the middleware was configured with.
+type claimsKeyLocal struct{}
+
+// GetClaims returns the JWT claims stored by JWTAuth.Middleware for this request,
+// using the ContextKey the middleware was configured with.
+// It returns ok=false if the middleware did not run or did not authenticate the request.
+//
+// Example:
+//
+//	claims, ok := auth.GetClaims(c)
+//	if !ok {
+//	    return fiber.ErrUnauthorized
+//	}
+//	userID, _ := auth.ClaimString(claims, "sub")
+func GetClaims(c *fiber.Ctx) (jwt.MapClaims, bool) {
+	key, ok := c.Locals(claimsKeyLocal{}).(string)
+	if !ok {
+		return nil, false
+	}
+	claims, ok := c.Locals(key).(jwt.MapClaims)
+	return claims, ok
+}
+
+// BindClaims decodes the JWT claims stored by JWTAuth.Middleware into out, by
+// JSON-marshalling the claims map and unmarshalling it into the caller's struct.
+// Use json tags on T to map claim names. It returns ErrJWTMissing if there are no claims.
+//
+// Example:
+//
+//	type UserClaims struct {
+//	    UserID string   `json:"sub"`
+//	    Email  string   `json:"email"`
+//	    Roles  []string `json:"roles"`
+//	}
+//
+//	var user UserClaims
+//	if err := auth.BindClaims(c, &user); err != nil {
+//	    return fiber.ErrUnauthorized
+//	}
+func BindClaims[T any](c *fiber.Ctx, out *T) error {
+	claims, ok := GetClaims(c)
+	if !ok {
+		return ErrJWTMissing
+	}
+
+	data, err := json.Marshal(claims)
+	if err != nil {
+		return fmt.Errorf("failed to encode claims: %w", err)
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("failed to bind claims: %w", err)
+	}
+	return nil
+}
+
+// ClaimString returns the string value of the given claim.
+// It returns ok=false if the claim is missing or is not a string.
+//
+// Example:
+//
+//	if email, ok := auth.ClaimString(claims, "email"); ok {
+//	    fmt.Println(email)
+//	}
+func ClaimString(claims jwt.MapClaims, key string) (string, bool) {
+	value, exists := claims[key]
+	if !exists {
+		return "", false
+	}
+	str, ok := value.(string)
+	return str, ok
+}
+
+// ClaimInt64 returns the integer value of the given claim.
+// Numeric claims are decoded as float64 by jwt.MapClaims; this helper converts them safely.
+// It returns ok=false if the claim is missing, not numeric, or not a whole number.
+//
+// Example:
+//
+//	if userID, ok := auth.ClaimInt64(claims, "user_id"); ok {
+//	    fmt.Println(userID)
+//	}
+func ClaimInt64(claims jwt.MapClaims, key string) (int64, bool) {
+	value, exists := claims[key]
+	if !exists {
+		return 0, false
+	}
+
+	switch v := value.(type) {
+	case float64:
+		if v != math.Trunc(v) || math.IsInf(v, 0) || math.IsNaN(v) {
+			return 0, false
+		}
+		return int64(v), true
+	case int64:
+		return v, true
+	case int:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case json.Number:
+		n, err := v.Int64()
+		if err != nil {
+			return 0, false
+		}
+		return n, true
+	default:
+		return 0, false
+	}
+}
+
+// ClaimTime returns the given numeric date claim (e.g., "exp", "iat", "nbf") as time.Time.
+// Fractional seconds are preserved. It returns ok=false if the claim is missing or not a numeric date.
+//
+// Example:
+//
+//	if expiresAt, ok := auth.ClaimTime(claims, "exp"); ok {
+//	    fmt.Println("Token expires at", expiresAt)
+//	}
+func ClaimTime(claims jwt.MapClaims, key string) (time.Time, bool) {
+	value, exists := claims[key]
+	if !exists {
+		return time.Time{}, false
+	}
+
+	switch v := value.(type) {
+	case float64:
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			return time.Time{}, false
+		}
+		sec, frac := math.Modf(v)
+		return time.Unix(int64(sec), int64(frac*1e9)), true
+	case int64:
+		return time.Unix(v, 0), true
+	case int:
+		return time.Unix(int64(v), 0), true
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return time.Time{}, false
+		}
+		sec, frac := math.Modf(f)
+		return time.Unix(int64(sec), int64(frac*1e9)), true
+	case *jwt.NumericDate:
+		if v == nil {
+			return time.Time{}, false
+		}
+		return v.Time, true
+	case jwt.NumericDate:
+		return v.Time, true
+	default:
+		return time.Time{}, false
+	}
+}
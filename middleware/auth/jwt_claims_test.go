@@ -0,0 +1,205 @@
+package auth
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestClaimTime(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	t.Run("float64_timestamp", func(t *testing.T) {
+		claims := jwt.MapClaims{"exp": float64(now.Unix())}
+		result, ok := ClaimTime(claims, "exp")
+		if !ok {
+			t.Fatal("Expected ok to be true")
+		}
+		if !result.Equal(now) {
+			t.Errorf("Expected %v, got %v", now, result)
+		}
+	})
+
+	t.Run("float64_with_fraction", func(t *testing.T) {
+		claims := jwt.MapClaims{"iat": 1700000000.5}
+		result, ok := ClaimTime(claims, "iat")
+		if !ok {
+			t.Fatal("Expected ok to be true")
+		}
+		expected := now.Add(500 * time.Millisecond)
+		if !result.Equal(expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("numeric_date", func(t *testing.T) {
+		claims := jwt.MapClaims{"exp": jwt.NewNumericDate(now)}
+		result, ok := ClaimTime(claims, "exp")
+		if !ok || !result.Equal(now) {
+			t.Errorf("Expected %v, got %v (ok=%v)", now, result, ok)
+		}
+	})
+
+	t.Run("parsed_token_claims", func(t *testing.T) {
+		token := generateTestToken("secret", jwt.MapClaims{"exp": now.Add(time.Hour).Unix()}, "HS256")
+		parsed, _ := jwt.Parse(token, func(token *jwt.Token) (interface{}, error) {
+			return []byte("secret"), nil
+		}, jwt.WithoutClaimsValidation())
+
+		result, ok := ClaimTime(parsed.Claims.(jwt.MapClaims), "exp")
+		if !ok || !result.Equal(now.Add(time.Hour)) {
+			t.Errorf("Expected %v, got %v (ok=%v)", now.Add(time.Hour), result, ok)
+		}
+	})
+
+	t.Run("string_claim", func(t *testing.T) {
+		claims := jwt.MapClaims{"exp": "1700000000"}
+		if _, ok := ClaimTime(claims, "exp"); ok {
+			t.Error("Expected ok to be false for string claim")
+		}
+	})
+
+	t.Run("missing_key", func(t *testing.T) {
+		if _, ok := ClaimTime(jwt.MapClaims{}, "exp"); ok {
+			t.Error("Expected ok to be false for missing claim")
+		}
+	})
+}
+
+func TestClaimString(t *testing.T) {
+	claims := jwt.MapClaims{
+		"email":   "test@example.com",
+		"user_id": float64(123),
+	}
+
+	t.Run("string_claim", func(t *testing.T) {
+		result, ok := ClaimString(claims, "email")
+		if !ok || result != "test@example.com" {
+			t.Errorf("Expected 'test@example.com', got '%s' (ok=%v)", result, ok)
+		}
+	})
+
+	t.Run("wrong_type", func(t *testing.T) {
+		if _, ok := ClaimString(claims, "user_id"); ok {
+			t.Error("Expected ok to be false for numeric claim")
+		}
+	})
+
+	t.Run("missing_key", func(t *testing.T) {
+		if _, ok := ClaimString(claims, "name"); ok {
+			t.Error("Expected ok to be false for missing claim")
+		}
+	})
+}
+
+func TestClaimInt64(t *testing.T) {
+	claims := jwt.MapClaims{
+		"user_id": float64(123),
+		"ratio":   1.5,
+		"email":   "test@example.com",
+		"count":   int64(7),
+	}
+
+	t.Run("float64_claim", func(t *testing.T) {
+		result, ok := ClaimInt64(claims, "user_id")
+		if !ok || result != 123 {
+			t.Errorf("Expected 123, got %d (ok=%v)", result, ok)
+		}
+	})
+
+	t.Run("int64_claim", func(t *testing.T) {
+		result, ok := ClaimInt64(claims, "count")
+		if !ok || result != 7 {
+			t.Errorf("Expected 7, got %d (ok=%v)", result, ok)
+		}
+	})
+
+	t.Run("fractional_float", func(t *testing.T) {
+		if _, ok := ClaimInt64(claims, "ratio"); ok {
+			t.Error("Expected ok to be false for fractional value")
+		}
+	})
+
+	t.Run("string_claim", func(t *testing.T) {
+		if _, ok := ClaimInt64(claims, "email"); ok {
+			t.Error("Expected ok to be false for string claim")
+		}
+	})
+
+	t.Run("missing_key", func(t *testing.T) {
+		if _, ok := ClaimInt64(claims, "missing"); ok {
+			t.Error("Expected ok to be false for missing claim")
+		}
+	})
+}
+
+func TestGetClaimsAndBindClaims(t *testing.T) {
+	type UserClaims struct {
+		UserID string   `json:"sub"`
+		Email  string   `json:"email"`
+		Roles  []string `json:"roles"`
+		Admin  bool     `json:"admin"`
+	}
+
+	secretKey := "test-secret-key"
+	jwtAuth := NewJWTAuth(JWTConfig{SecretKey: secretKey, ContextKey: "auth_user"})
+
+	app := fiber.New()
+	app.Get("/me", jwtAuth.Middleware(), func(c *fiber.Ctx) error {
+		claims, ok := GetClaims(c)
+		if !ok {
+			return c.SendStatus(fiber.StatusInternalServerError)
+		}
+		if claims["email"] != "john@example.com" {
+			t.Errorf("Expected email claim, got %v", claims["email"])
+		}
+
+		var user UserClaims
+		if err := BindClaims(c, &user); err != nil {
+			t.Errorf("BindClaims failed: %v", err)
+		}
+		if user.UserID != "user-1" || user.Email != "john@example.com" || !user.Admin {
+			t.Errorf("Unexpected bound claims: %+v", user)
+		}
+		if len(user.Roles) != 2 || user.Roles[1] != "editor" {
+			t.Errorf("Expected roles to be bound, got %v", user.Roles)
+		}
+		return c.SendStatus(fiber.StatusOK)
+	})
+	app.Get("/anonymous", func(c *fiber.Ctx) error {
+		if _, ok := GetClaims(c); ok {
+			t.Error("Expected no claims without middleware")
+		}
+		var user UserClaims
+		if err := BindClaims(c, &user); !errors.Is(err, ErrJWTMissing) {
+			t.Errorf("Expected ErrJWTMissing, got %v", err)
+		}
+		return c.SendStatus(fiber.StatusOK)
+	})
+
+	token := generateTestToken(secretKey, jwt.MapClaims{
+		"sub":   "user-1",
+		"email": "john@example.com",
+		"roles": []string{"viewer", "editor"},
+		"admin": true,
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	}, "HS256")
+
+	req := httptest.NewRequest("GET", "/me", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != fiber.StatusOK {
+		t.Errorf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	if _, err := app.Test(httptest.NewRequest("GET", "/anonymous", nil)); err != nil {
+		t.Fatal(err)
+	}
+}
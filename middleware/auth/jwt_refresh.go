@@ -0,0 +1,135 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// tokenTypeClaim marks refresh tokens so they cannot be used as access tokens
+	tokenTypeClaim   = "typ"
+	refreshTokenType = "refresh"
+
+	// accessTTLClaim stores the access token lifetime (seconds) in the refresh token,
+	// so RefreshToken issues access tokens with the same lifetime as GenerateTokenPair
+	accessTTLClaim = "access_ttl"
+)
+
+// ErrJWTNotRefreshToken indicates that a token passed to RefreshToken is not a refresh token
+var ErrJWTNotRefreshToken = errors.New("not a refresh token")
+
+// isRefreshToken reports whether the claims belong to a refresh token
+func isRefreshToken(claims jwt.MapClaims) bool {
+	typ, _ := ClaimString(claims, tokenTypeClaim)
+	return typ == refreshTokenType
+}
+
+// GenerateTokenPair issues a short-lived access token and a long-lived refresh token
+// carrying the same claims. Each token gets its own "jti"; the refresh token is marked
+// with "typ": "refresh" and is rejected by Middleware and ParseAndValidate.
+//
+// Example:
+//
+//	access, refresh, err := jwtAuth.GenerateTokenPair(jwt.MapClaims{
+//	    "sub":  user.ID,
+//	    "role": user.Role,
+//	}, 15*time.Minute, 30*24*time.Hour)
+func (j *JWTAuth) GenerateTokenPair(claims jwt.MapClaims, accessTTL, refreshTTL time.Duration) (access, refresh string, err error) {
+	return j.generateTokenPair(claims, accessTTL, time.Now().Add(refreshTTL))
+}
+
+// generateTokenPair issues the token pair with the refresh token expiring at refreshExpiresAt
+func (j *JWTAuth) generateTokenPair(claims jwt.MapClaims, accessTTL time.Duration, refreshExpiresAt time.Time) (access, refresh string, err error) {
+	accessClaims := make(jwt.MapClaims, len(claims))
+	refreshClaims := make(jwt.MapClaims, len(claims)+2)
+	for k, v := range claims {
+		// Each token needs a distinct jti so revoking one does not revoke the other
+		if k == "jti" {
+			continue
+		}
+		accessClaims[k] = v
+		refreshClaims[k] = v
+	}
+	refreshClaims[tokenTypeClaim] = refreshTokenType
+	refreshClaims[accessTTLClaim] = accessTTL.Seconds()
+
+	now := time.Now()
+	access, err = j.signToken(accessClaims, now, now.Add(accessTTL), "")
+	if err != nil {
+		return "", "", err
+	}
+	refresh, err = j.signToken(refreshClaims, now, refreshExpiresAt, "")
+	if err != nil {
+		return "", "", err
+	}
+	return access, refresh, nil
+}
+
+// RefreshToken validates a refresh token issued by GenerateTokenPair and rotates it:
+// the old refresh token's jti is revoked and a new access token and refresh token are
+// issued with the same claims. The access token keeps its lifetime, while the new refresh
+// token keeps the original absolute expiry, so a session cannot be extended forever by
+// refreshing. Reusing a rotated refresh token fails with ErrJWTRevoked, including when
+// two requests race with the same token: the check and the revocation are one atomic step.
+//
+// Rotation requires a Revoker implementing RevocationStore (e.g., NewMemoryRevoker or
+// NewRedisRevoker); otherwise ErrRevokeUnsupported is returned and no tokens are issued.
+//
+// Example:
+//
+//	app.Post("/auth/refresh", func(c *fiber.Ctx) error {
+//	    access, refresh, err := jwtAuth.RefreshToken(c.FormValue("refresh_token"))
+//	    if err != nil {
+//	        return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "Invalid refresh token"})
+//	    }
+//	    return c.JSON(fiber.Map{"access_token": access, "refresh_token": refresh})
+//	})
+func (j *JWTAuth) RefreshToken(refresh string) (access, newRefresh string, err error) {
+	j.mu.RLock()
+	store, ok := j.config.Revoker.(RevocationStore)
+	j.mu.RUnlock()
+	if !ok {
+		return "", "", ErrRevokeUnsupported
+	}
+
+	claims, err := j.validateToken(refresh)
+	if err != nil {
+		return "", "", err
+	}
+	if !isRefreshToken(claims) {
+		return "", "", fmt.Errorf("%w: %w", ErrJWTInvalid, ErrJWTNotRefreshToken)
+	}
+
+	jti, ok := ClaimString(claims, "jti")
+	if !ok || jti == "" {
+		return "", "", fmt.Errorf("%w: refresh token has no jti", ErrJWTInvalid)
+	}
+	expiresAt, _ := ClaimTime(claims, "exp")
+	accessSeconds, _ := claims[accessTTLClaim].(float64)
+
+	// Invalidate the old refresh token before issuing new ones. Only the caller that
+	// revokes it may rotate, so concurrent reuse of the same token is rejected.
+	revoked, err := store.RevokeIfNotRevoked(jti, expiresAt)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to revoke refresh token: %w", err)
+	}
+	if !revoked {
+		return "", "", fmt.Errorf("%w: %w", ErrJWTInvalid, ErrJWTRevoked)
+	}
+
+	// Carry over the caller's claims; registered claims are set again when signing
+	base := make(jwt.MapClaims, len(claims))
+	for k, v := range claims {
+		switch k {
+		case "exp", "iat", "nbf", "jti", tokenTypeClaim, accessTTLClaim:
+			continue
+		}
+		base[k] = v
+	}
+
+	accessTTL := time.Duration(accessSeconds * float64(time.Second))
+	return j.generateTokenPair(base, accessTTL, expiresAt)
+}
@@ -0,0 +1,186 @@
+package auth
+
+import (
+	"errors"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestJWTAuth_GenerateTokenPair(t *testing.T) {
+	jwtAuth := NewJWTAuth(JWTConfig{SecretKey: "test-secret-key", Revoker: NewMemoryRevoker()})
+
+	access, refresh, err := jwtAuth.GenerateTokenPair(jwt.MapClaims{"sub": "user-1", "jti": "caller-jti"}, 15*time.Minute, 24*time.Hour)
+	if err != nil {
+		t.Fatalf("GenerateTokenPair failed: %v", err)
+	}
+
+	accessClaims, err := jwtAuth.ParseAndValidate(access)
+	if err != nil {
+		t.Fatalf("Expected access token to validate, got %v", err)
+	}
+	if accessClaims["sub"] != "user-1" {
+		t.Errorf("Expected sub claim, got %v", accessClaims["sub"])
+	}
+	if accessClaims["jti"] == "caller-jti" {
+		t.Error("Expected a fresh jti on the access token")
+	}
+	if exp, _ := ClaimTime(accessClaims, "exp"); time.Until(exp) > 15*time.Minute {
+		t.Errorf("Expected access token to expire within 15 minutes, got %v", exp)
+	}
+
+	// Refresh tokens must not authenticate requests
+	if _, err := jwtAuth.ParseAndValidate(refresh); !errors.Is(err, ErrJWTInvalid) {
+		t.Errorf("Expected refresh token to be rejected as access token, got %v", err)
+	}
+
+	app := fiber.New()
+	app.Use(jwtAuth.Middleware())
+	app.Get("/me", func(c *fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+	for token, wantStatus := range map[string]int{access: fiber.StatusOK, refresh: fiber.StatusUnauthorized} {
+		req := httptest.NewRequest("GET", "/me", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != wantStatus {
+			t.Errorf("Expected status %d, got %d", wantStatus, resp.StatusCode)
+		}
+	}
+}
+
+func TestJWTAuth_RefreshToken(t *testing.T) {
+	jwtAuth := NewJWTAuth(JWTConfig{SecretKey: "test-secret-key", Revoker: NewMemoryRevoker()})
+
+	access, refresh, err := jwtAuth.GenerateTokenPair(jwt.MapClaims{"sub": "user-1", "role": "admin"}, 15*time.Minute, 24*time.Hour)
+	if err != nil {
+		t.Fatalf("GenerateTokenPair failed: %v", err)
+	}
+
+	newAccess, newRefresh, err := jwtAuth.RefreshToken(refresh)
+	if err != nil {
+		t.Fatalf("RefreshToken failed: %v", err)
+	}
+	if newRefresh == refresh {
+		t.Error("Expected refresh token to be rotated")
+	}
+
+	claims, err := jwtAuth.ParseAndValidate(newAccess)
+	if err != nil {
+		t.Fatalf("Expected new access token to validate, got %v", err)
+	}
+	if claims["sub"] != "user-1" || claims["role"] != "admin" {
+		t.Errorf("Expected claims to be carried over, got %v", claims)
+	}
+	if _, ok := claims[accessTTLClaim]; ok {
+		t.Error("Expected internal refresh claims not to leak into the access token")
+	}
+	if exp, _ := ClaimTime(claims, "exp"); time.Until(exp) > 15*time.Minute || time.Until(exp) < 14*time.Minute {
+		t.Errorf("Expected access TTL to be kept, got expiry %v", exp)
+	}
+
+	refreshClaims, err := jwtAuth.validateToken(newRefresh)
+	if err != nil {
+		t.Fatalf("Expected new refresh token to validate, got %v", err)
+	}
+	if exp, _ := ClaimTime(refreshClaims, "exp"); time.Until(exp) < 23*time.Hour {
+		t.Errorf("Expected refresh TTL to be kept, got expiry %v", exp)
+	}
+
+	// The rotated refresh token cannot be reused
+	if _, _, err := jwtAuth.RefreshToken(refresh); !errors.Is(err, ErrJWTRevoked) {
+		t.Errorf("Expected reused refresh token to be revoked, got %v", err)
+	}
+
+	// Access tokens cannot be used to refresh
+	if _, _, err := jwtAuth.RefreshToken(access); !errors.Is(err, ErrJWTNotRefreshToken) {
+		t.Errorf("Expected ErrJWTNotRefreshToken, got %v", err)
+	}
+
+	// The new refresh token keeps working
+	if _, _, err := jwtAuth.RefreshToken(newRefresh); err != nil {
+		t.Errorf("Expected new refresh token to work, got %v", err)
+	}
+}
+
+func TestJWTAuth_RefreshToken_RequiresRevoker(t *testing.T) {
+	jwtAuth := NewJWTAuth(JWTConfig{SecretKey: "test-secret-key"})
+
+	_, refresh, err := jwtAuth.GenerateTokenPair(jwt.MapClaims{"sub": "user-1"}, time.Minute, time.Hour)
+	if err != nil {
+		t.Fatalf("GenerateTokenPair failed: %v", err)
+	}
+	if _, _, err := jwtAuth.RefreshToken(refresh); !errors.Is(err, ErrRevokeUnsupported) {
+		t.Errorf("Expected ErrRevokeUnsupported, got %v", err)
+	}
+}
+
+func TestJWTAuth_RefreshToken_ConcurrentReuse(t *testing.T) {
+	for name, revoker := range map[string]Revoker{
+		"memory": NewMemoryRevoker(),
+		"redis":  NewRedisRevoker(newFakeRedisClient()),
+	} {
+		t.Run(name, func(t *testing.T) {
+			jwtAuth := NewJWTAuth(JWTConfig{SecretKey: "test-secret-key", Revoker: revoker})
+			_, refresh, err := jwtAuth.GenerateTokenPair(jwt.MapClaims{"sub": "user-1"}, time.Minute, time.Hour)
+			if err != nil {
+				t.Fatalf("GenerateTokenPair failed: %v", err)
+			}
+
+			const attempts = 20
+			var wg sync.WaitGroup
+			var mu sync.Mutex
+			succeeded := 0
+			for i := 0; i < attempts; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					_, _, err := jwtAuth.RefreshToken(refresh)
+					if err == nil {
+						mu.Lock()
+						succeeded++
+						mu.Unlock()
+					} else if !errors.Is(err, ErrJWTRevoked) {
+						t.Errorf("Expected ErrJWTRevoked, got %v", err)
+					}
+				}()
+			}
+			wg.Wait()
+
+			if succeeded != 1 {
+				t.Errorf("Expected exactly one successful rotation, got %d", succeeded)
+			}
+		})
+	}
+}
+
+func TestJWTAuth_RefreshToken_KeepsAbsoluteExpiry(t *testing.T) {
+	jwtAuth := NewJWTAuth(JWTConfig{SecretKey: "test-secret-key", Revoker: NewMemoryRevoker()})
+
+	_, refresh, err := jwtAuth.GenerateTokenPair(jwt.MapClaims{"sub": "user-1"}, time.Minute, time.Hour)
+	if err != nil {
+		t.Fatalf("GenerateTokenPair failed: %v", err)
+	}
+	original, _ := jwtAuth.validateToken(refresh)
+	originalExp, _ := ClaimTime(original, "exp")
+
+	// Rotating repeatedly must never push the session expiry forward
+	for i := 0; i < 2; i++ {
+		time.Sleep(1100 * time.Millisecond)
+		_, refresh, err = jwtAuth.RefreshToken(refresh)
+		if err != nil {
+			t.Fatalf("RefreshToken %d failed: %v", i, err)
+		}
+		claims, _ := jwtAuth.validateToken(refresh)
+		if exp, _ := ClaimTime(claims, "exp"); !exp.Equal(originalExp) {
+			t.Fatalf("Expected refresh expiry %v to be kept, got %v", originalExp, exp)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrJWTRevoked indicates that the token's "jti" has been revoked, e.g. on logout
+	ErrJWTRevoked = errors.New("token has been revoked")
+
+	// ErrRevokeUnsupported indicates that the configured Revoker cannot record revocations
+	ErrRevokeUnsupported = errors.New("revoker does not support revoking tokens")
+)
+
+// Revoker reports whether a token ID ("jti" claim) has been revoked.
+// Tokens whose jti is revoked are rejected even if their signature and expiry are valid.
+type Revoker interface {
+	IsRevoked(jti string) bool
+}
+
+// RevocationStore is a Revoker that can also record revocations.
+// Entries only need to be kept until the token would have expired anyway.
+//
+// RevokeIfNotRevoked must check and revoke atomically (like Redis SETNX): it returns
+// true only for the one caller that revoked jti, and false if jti was already revoked.
+// RefreshToken relies on it so a refresh token can be rotated only once.
+type RevocationStore interface {
+	Revoker
+	Revoke(jti string, until time.Time) error
+	RevokeIfNotRevoked(jti string, until time.Time) (bool, error)
+}
+
+// MemoryRevoker is an in-memory RevocationStore. Revocations are lost on restart and
+// are not shared between instances; use RedisRevoker for multi-instance deployments.
+type MemoryRevoker struct {
+	mu      sync.RWMutex
+	revoked map[string]time.Time
+}
+
+// NewMemoryRevoker creates an empty in-memory revocation list
+func NewMemoryRevoker() *MemoryRevoker {
+	return &MemoryRevoker{
+		revoked: make(map[string]time.Time),
+	}
+}
+
+// IsRevoked reports whether jti is revoked and the revocation has not lapsed yet
+func (m *MemoryRevoker) IsRevoked(jti string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	until, ok := m.revoked[jti]
+	return ok && time.Now().Before(until)
+}
+
+// Revoke blacklists jti until the given time, usually the token's expiry.
+// Lapsed entries are dropped on each call to keep the list bounded.
+func (m *MemoryRevoker) Revoke(jti string, until time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.pruneLocked(time.Now())
+	m.revoked[jti] = until
+	return nil
+}
+
+// RevokeIfNotRevoked revokes jti until the given time unless it is already revoked.
+// It returns false when jti was already revoked.
+func (m *MemoryRevoker) RevokeIfNotRevoked(jti string, until time.Time) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	if exp, ok := m.revoked[jti]; ok && now.Before(exp) {
+		return false, nil
+	}
+	m.pruneLocked(now)
+	m.revoked[jti] = until
+	return true, nil
+}
+
+// pruneLocked drops lapsed entries; the caller must hold m.mu
+func (m *MemoryRevoker) pruneLocked(now time.Time) {
+	for id, exp := range m.revoked {
+		if !now.Before(exp) {
+			delete(m.revoked, id)
+		}
+	}
+}
+
+// RedisClient is the subset of a Redis client used by RedisRevoker.
+// Wrap your client (e.g. go-redis) to satisfy it:
+//
+//	type goRedisClient struct{ rdb *redis.Client }
+//
+//	func (g goRedisClient) Set(ctx context.Context, key, value string, ttl time.Duration) error {
+//	    return g.rdb.Set(ctx, key, value, ttl).Err()
+//	}
+//
+//	func (g goRedisClient) SetNX(ctx context.Context, key, value string, ttl time.Duration) (bool, error) {
+//	    return g.rdb.SetNX(ctx, key, value, ttl).Result()
+//	}
+//
+//	func (g goRedisClient) Exists(ctx context.Context, key string) (bool, error) {
+//	    n, err := g.rdb.Exists(ctx, key).Result()
+//	    return n > 0, err
+//	}
+type RedisClient interface {
+	Set(ctx context.Context, key, value string, ttl time.Duration) error
+	// SetNX sets key only if it does not exist and reports whether it was set
+	SetNX(ctx context.Context, key, value string, ttl time.Duration) (bool, error)
+	Exists(ctx context.Context, key string) (bool, error)
+}
+
+// RedisRevoker is a RevocationStore backed by Redis, so revocations are shared between
+// instances. Each revoked jti is stored under Prefix+jti with a TTL, letting Redis expire
+// entries once the token is no longer valid anyway.
+type RedisRevoker struct {
+	client RedisClient
+
+	// Prefix is prepended to the jti to build the Redis key (default: "jwt:revoked:")
+	Prefix string
+
+	// Timeout bounds each Redis call (default: 2 seconds)
+	Timeout time.Duration
+}
+
+// NewRedisRevoker creates a Redis-backed revocation list using the given client
+func NewRedisRevoker(client RedisClient) *RedisRevoker {
+	return &RedisRevoker{
+		client:  client,
+		Prefix:  "jwt:revoked:",
+		Timeout: 2 * time.Second,
+	}
+}
+
+// IsRevoked reports whether jti is revoked. If Redis cannot be reached the token is
+// treated as revoked, so an outage never lets a logged-out token through.
+func (r *RedisRevoker) IsRevoked(jti string) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	exists, err := r.client.Exists(ctx, r.Prefix+jti)
+	if err != nil {
+		return true
+	}
+	return exists
+}
+
+// Revoke blacklists jti until the given time, usually the token's expiry.
+// Times in the past are ignored since the token is already expired.
+func (r *RedisRevoker) Revoke(jti string, until time.Time) error {
+	ttl := time.Until(until)
+	if ttl <= 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	return r.client.Set(ctx, r.Prefix+jti, "1", ttl)
+}
+
+// RevokeIfNotRevoked revokes jti until the given time with SETNX, so only one caller
+// can revoke it. It returns false when jti was already revoked or until has passed.
+func (r *RedisRevoker) RevokeIfNotRevoked(jti string, until time.Time) (bool, error) {
+	ttl := time.Until(until)
+	if ttl <= 0 {
+		return false, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	return r.client.SetNX(ctx, r.Prefix+jti, "1", ttl)
+}
+
+// Revoke blacklists a token ID until the given time using the configured Revoker,
+// so handlers can invalidate a token on logout. The Revoker must implement RevocationStore.
+//
+// Example:
+//
+//	app.Post("/logout", func(c *fiber.Ctx) error {
+//	    claims := c.Locals("claims").(jwt.MapClaims)
+//	    exp, _ := claims.GetExpirationTime()
+//	    if err := jwtAuth.Revoke(claims["jti"].(string), exp.Time); err != nil {
+//	        return err
+//	    }
+//	    return c.SendStatus(fiber.StatusNoContent)
+//	})
+func (j *JWTAuth) Revoke(jti string, until time.Time) error {
+	j.mu.RLock()
+	revoker := j.config.Revoker
+	j.mu.RUnlock()
+
+	store, ok := revoker.(RevocationStore)
+	if !ok {
+		return ErrRevokeUnsupported
+	}
+	return store.Revoke(jti, until)
+}
@@ -0,0 +1,196 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// fakeRedisClient is an in-memory RedisClient for testing RedisRevoker
+type fakeRedisClient struct {
+	mu   sync.Mutex
+	data map[string]time.Time
+	err  error
+}
+
+func newFakeRedisClient() *fakeRedisClient {
+	return &fakeRedisClient{data: make(map[string]time.Time)}
+}
+
+func (f *fakeRedisClient) Set(ctx context.Context, key, value string, ttl time.Duration) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.data[key] = time.Now().Add(ttl)
+	return nil
+}
+
+func (f *fakeRedisClient) SetNX(ctx context.Context, key, value string, ttl time.Duration) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return false, f.err
+	}
+	if exp, ok := f.data[key]; ok && time.Now().Before(exp) {
+		return false, nil
+	}
+	f.data[key] = time.Now().Add(ttl)
+	return true, nil
+}
+
+func (f *fakeRedisClient) Exists(ctx context.Context, key string) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return false, f.err
+	}
+	exp, ok := f.data[key]
+	return ok && time.Now().Before(exp), nil
+}
+
+func TestMemoryRevoker(t *testing.T) {
+	revoker := NewMemoryRevoker()
+
+	if revoker.IsRevoked("token-1") {
+		t.Error("Expected token-1 not to be revoked")
+	}
+
+	revoker.Revoke("token-1", time.Now().Add(time.Hour))
+	if !revoker.IsRevoked("token-1") {
+		t.Error("Expected token-1 to be revoked")
+	}
+
+	revoker.Revoke("token-2", time.Now().Add(-time.Second))
+	if revoker.IsRevoked("token-2") {
+		t.Error("Expected lapsed revocation to be ignored")
+	}
+
+	revoker.Revoke("token-3", time.Now().Add(time.Hour))
+	if _, ok := revoker.revoked["token-2"]; ok {
+		t.Error("Expected lapsed entry to be dropped")
+	}
+}
+
+func TestRedisRevoker(t *testing.T) {
+	client := newFakeRedisClient()
+	revoker := NewRedisRevoker(client)
+
+	if err := revoker.Revoke("token-1", time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("Revoke failed: %v", err)
+	}
+	if _, ok := client.data["jwt:revoked:token-1"]; !ok {
+		t.Error("Expected key with default prefix to be set")
+	}
+	if !revoker.IsRevoked("token-1") {
+		t.Error("Expected token-1 to be revoked")
+	}
+	if revoker.IsRevoked("token-2") {
+		t.Error("Expected token-2 not to be revoked")
+	}
+
+	if err := revoker.Revoke("token-3", time.Now().Add(-time.Second)); err != nil {
+		t.Fatalf("Revoke failed: %v", err)
+	}
+	if _, ok := client.data["jwt:revoked:token-3"]; ok {
+		t.Error("Expected already expired token not to be stored")
+	}
+
+	client.err = errors.New("connection refused")
+	if !revoker.IsRevoked("token-2") {
+		t.Error("Expected tokens to be treated as revoked when Redis is unavailable")
+	}
+}
+
+func TestJWTAuth_Revocation(t *testing.T) {
+	jwtAuth := NewJWTAuth(JWTConfig{SecretKey: "test-secret-key", Revoker: NewMemoryRevoker()})
+
+	app := fiber.New()
+	app.Use(jwtAuth.Middleware())
+	app.Get("/me", func(c *fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+	app.Post("/logout", func(c *fiber.Ctx) error {
+		claims := c.Locals(jwtAuth.GetContextKey()).(jwt.MapClaims)
+		exp, err := claims.GetExpirationTime()
+		if err != nil {
+			return err
+		}
+		if err := jwtAuth.Revoke(claims["jti"].(string), exp.Time); err != nil {
+			return err
+		}
+		return c.SendStatus(fiber.StatusNoContent)
+	})
+
+	token, err := jwtAuth.GenerateToken(jwt.MapClaims{"sub": "user-1"}, time.Hour)
+	if err != nil {
+		t.Fatalf("GenerateToken failed: %v", err)
+	}
+	other, _ := jwtAuth.GenerateToken(jwt.MapClaims{"sub": "user-1"}, time.Hour)
+
+	request := func(method, path, token string) int {
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.StatusCode
+	}
+
+	if status := request("GET", "/me", token); status != fiber.StatusOK {
+		t.Fatalf("Expected status 200 before logout, got %d", status)
+	}
+	if status := request("POST", "/logout", token); status != fiber.StatusNoContent {
+		t.Fatalf("Expected status 204 from logout, got %d", status)
+	}
+	if status := request("GET", "/me", token); status != fiber.StatusUnauthorized {
+		t.Errorf("Expected status 401 after logout, got %d", status)
+	}
+	if status := request("GET", "/me", other); status != fiber.StatusOK {
+		t.Errorf("Expected other token to stay valid, got %d", status)
+	}
+
+	_, err = jwtAuth.ParseAndValidate(token)
+	if !errors.Is(err, ErrJWTRevoked) || !errors.Is(err, ErrJWTInvalid) {
+		t.Errorf("Expected ErrJWTRevoked wrapped in ErrJWTInvalid, got %v", err)
+	}
+}
+
+func TestJWTAuth_RevokeUnsupported(t *testing.T) {
+	jwtAuth := NewJWTAuth(JWTConfig{SecretKey: "test-secret-key"})
+	if err := jwtAuth.Revoke("token-1", time.Now().Add(time.Hour)); !errors.Is(err, ErrRevokeUnsupported) {
+		t.Errorf("Expected ErrRevokeUnsupported, got %v", err)
+	}
+}
+
+func TestRevokeIfNotRevoked(t *testing.T) {
+	for name, store := range map[string]RevocationStore{
+		"memory": NewMemoryRevoker(),
+		"redis":  NewRedisRevoker(newFakeRedisClient()),
+	} {
+		t.Run(name, func(t *testing.T) {
+			until := time.Now().Add(time.Hour)
+
+			ok, err := store.RevokeIfNotRevoked("token-1", until)
+			if err != nil || !ok {
+				t.Fatalf("Expected first call to revoke, got %v %v", ok, err)
+			}
+			if !store.IsRevoked("token-1") {
+				t.Error("Expected token-1 to be revoked")
+			}
+
+			ok, err = store.RevokeIfNotRevoked("token-1", until)
+			if err != nil || ok {
+				t.Errorf("Expected second call to report already revoked, got %v %v", ok, err)
+			}
+		})
+	}
+}
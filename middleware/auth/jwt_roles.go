@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// RequireRoles returns a middleware that allows the request only if the JWT claims stored
+// by Middleware under ContextKey contain one of the given roles, either as the "role"
+// string claim or inside the "roles" array claim. It must be registered after Middleware.
+//
+// Requests without claims get 401 (Middleware did not run or failed); requests whose token
+// lacks all of the roles get 403. Both use the same JSON envelope as Middleware.
+//
+// Example:
+//
+//	admin := app.Group("/admin", jwtAuth.Middleware(), jwtAuth.RequireRoles("admin"))
+//	app.Delete("/posts/:id", jwtAuth.Middleware(), jwtAuth.RequireRoles("admin", "editor"), deletePost)
+func (j *JWTAuth) RequireRoles(roles ...string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		claims, ok := c.Locals(j.GetContextKey()).(jwt.MapClaims)
+		if !ok {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error":   "Unauthorized",
+				"message": ErrJWTMissing.Error(),
+			})
+		}
+
+		if !hasAnyRole(claims, roles) {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error":   "Forbidden",
+				"message": "Insufficient role",
+			})
+		}
+
+		return c.Next()
+	}
+}
+
+// hasAnyRole reports whether the "role" or "roles" claim contains one of the roles
+func hasAnyRole(claims jwt.MapClaims, roles []string) bool {
+	granted := make(map[string]struct{})
+	if role, ok := ClaimString(claims, "role"); ok {
+		granted[role] = struct{}{}
+	}
+	if list, ok := claims["roles"].([]interface{}); ok {
+		for _, v := range list {
+			if role, ok := v.(string); ok {
+				granted[role] = struct{}{}
+			}
+		}
+	}
+
+	for _, role := range roles {
+		if _, ok := granted[role]; ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestJWTAuth_RequireRoles(t *testing.T) {
+	secretKey := "test-secret-key"
+	jwtAuth := NewJWTAuth(JWTConfig{SecretKey: secretKey})
+
+	app := fiber.New()
+	app.Get("/admin", jwtAuth.Middleware(), jwtAuth.RequireRoles("admin", "owner"), func(c *fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+	app.Get("/unprotected", jwtAuth.RequireRoles("admin"), func(c *fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+
+	tests := []struct {
+		name       string
+		claims     jwt.MapClaims
+		wantStatus int
+	}{
+		{"role_claim", jwt.MapClaims{"role": "admin"}, fiber.StatusOK},
+		{"roles_array", jwt.MapClaims{"roles": []string{"viewer", "owner"}}, fiber.StatusOK},
+		{"wrong_role", jwt.MapClaims{"role": "viewer"}, fiber.StatusForbidden},
+		{"wrong_roles_array", jwt.MapClaims{"roles": []string{"viewer", "editor"}}, fiber.StatusForbidden},
+		{"no_role", jwt.MapClaims{"sub": "user-1"}, fiber.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.claims["exp"] = time.Now().Add(time.Hour).Unix()
+			token := generateTestToken(secretKey, tt.claims, "HS256")
+
+			req := httptest.NewRequest("GET", "/admin", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+		})
+	}
+
+	t.Run("without_jwt_middleware", func(t *testing.T) {
+		resp, err := app.Test(httptest.NewRequest("GET", "/unprotected", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != fiber.StatusUnauthorized {
+			t.Errorf("Expected status 401, got %d", resp.StatusCode)
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/keyauth"
+)
+
+const (
+	// maskKeyVisible is the number of characters MaskKey reveals at each end of a long key
+	maskKeyVisible = 4
+
+	// maskKeyMinLength is the shortest key MaskKey partially reveals; shorter keys are fully masked
+	maskKeyMinLength = 16
+)
+
+// FailureLogger is called when authentication fails, e.g. to log the attempt.
+// maskedKey is the credential sent by the client masked with MaskKey, or empty if none was sent.
+type FailureLogger func(c *fiber.Ctx, maskedKey string, err error)
+
+// MaskKey masks a credential so it can be written to logs safely.
+// Keys of 16 characters or more keep their first and last 4 characters ("abcd…wxyz");
+// shorter keys are replaced entirely by "****" so that neither content nor length leaks.
+// An empty key returns an empty string.
+//
+// Example:
+//
+//	auth.MaskKey("sk_live_1234567890abcdef") // "sk_l…cdef"
+//	auth.MaskKey("secret")                   // "****"
+func MaskKey(key string) string {
+	if key == "" {
+		return ""
+	}
+
+	runes := []rune(key)
+	if len(runes) < maskKeyMinLength {
+		return "****"
+	}
+	return string(runes[:maskKeyVisible]) + "…" + string(runes[len(runes)-maskKeyVisible:])
+}
+
+// keyauthErrorHandler wraps a keyauth error handler so that failures are reported to
+// the logger with the masked key first. keyFn extracts the raw key from the request.
+// Without a custom handler, ErrIPNotAllowed gets 403 and other errors keyauth's default 401.
+func keyauthErrorHandler(handler fiber.ErrorHandler, logger FailureLogger, keyFn func(c *fiber.Ctx) string) fiber.ErrorHandler {
+	if handler == nil {
+		handler = func(c *fiber.Ctx, err error) error {
+			if errors.Is(err, ErrIPNotAllowed) {
+				return c.Status(fiber.StatusForbidden).SendString(err.Error())
+			}
+			return keyauth.ConfigDefault.ErrorHandler(c, err)
+		}
+	}
+	if logger == nil {
+		return handler
+	}
+
+	return func(c *fiber.Ctx, err error) error {
+		logger(c, MaskKey(strings.TrimSpace(keyFn(c))), err)
+		return handler(c, err)
+	}
+}
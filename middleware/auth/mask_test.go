@@ -0,0 +1,182 @@
+package auth
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestMaskKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      string
+		expected string
+	}{
+		{"empty", "", ""},
+		{"short", "secret", "****"},
+		{"just_below_threshold", "123456789012345", "****"},
+		{"threshold", "1234567890123456", "1234…3456"},
+		{"long", "sk_live_1234567890abcdef", "sk_l…cdef"},
+		{"multibyte", "ключ-ключ-ключ-ключ", "ключ…ключ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MaskKey(tt.key); got != tt.expected {
+				t.Errorf("MaskKey(%q) = %q, want %q", tt.key, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFailureLogger(t *testing.T) {
+	const attemptedKey = "sk_live_1234567890abcdef"
+
+	type failure struct {
+		maskedKey string
+		err       error
+	}
+	var logged []failure
+	logger := func(c *fiber.Ctx, maskedKey string, err error) {
+		logged = append(logged, failure{maskedKey, err})
+	}
+
+	keyProvider := NewBaseKeyProvider()
+	keyProvider.Add("valid-key-1234567890")
+
+	tests := []struct {
+		name       string
+		middleware fiber.Handler
+	}{
+		{
+			name:       "header",
+			middleware: NewHeaderAuth(HeaderAuthConfig{KeyProvider: keyProvider, FailureLogger: logger}).Middleware(),
+		},
+		{
+			name:       "querystring",
+			middleware: NewDefaultQueryStringAuth(QueryStringAuthConfig{KeyProvider: keyProvider, ParamName: "access-token", FailureLogger: logger}).Middleware(),
+		},
+		{
+			name:       "jwt",
+			middleware: NewJWTAuth(JWTConfig{SecretKey: "secret", FailureLogger: logger}).Middleware(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logged = nil
+
+			app := fiber.New()
+			app.Use(tt.middleware)
+			app.Get("/test", func(c *fiber.Ctx) error {
+				return c.SendString("ok")
+			})
+
+			req := httptest.NewRequest("GET", "/test?access-token="+attemptedKey, nil)
+			req.Header.Set("X-API-Key", attemptedKey)
+			req.Header.Set("Authorization", "Bearer "+attemptedKey)
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != fiber.StatusUnauthorized {
+				t.Errorf("Expected status 401, got %d", resp.StatusCode)
+			}
+
+			if len(logged) != 1 {
+				t.Fatalf("Expected one logged failure, got %d", len(logged))
+			}
+			if logged[0].maskedKey != "sk_l…cdef" {
+				t.Errorf("Expected masked key 'sk_l…cdef', got %q", logged[0].maskedKey)
+			}
+			if strings.Contains(logged[0].maskedKey, "1234567890") {
+				t.Errorf("Masked key leaks the credential: %q", logged[0].maskedKey)
+			}
+			if logged[0].err == nil {
+				t.Error("Expected the failure error to be passed")
+			}
+		})
+	}
+
+	t.Run("basic", func(t *testing.T) {
+		logged = nil
+		const username = "admin@example.com.internal"
+
+		app := fiber.New()
+		app.Use(NewBasicAuth(BasicAuthConfig{KeyProvider: keyProvider, FailureLogger: logger}).Middleware())
+		app.Get("/test", func(c *fiber.Ctx) error {
+			return c.SendString("ok")
+		})
+
+		req := httptest.NewRequest("GET", "/test", nil)
+		req.SetBasicAuth(username, "wrong-password")
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != fiber.StatusUnauthorized {
+			t.Errorf("Expected status 401, got %d", resp.StatusCode)
+		}
+		if resp.Header.Get("WWW-Authenticate") == "" {
+			t.Error("Expected the default WWW-Authenticate challenge")
+		}
+
+		if len(logged) != 1 {
+			t.Fatalf("Expected one logged failure, got %d", len(logged))
+		}
+		if logged[0].maskedKey != MaskKey(username) || strings.Contains(logged[0].maskedKey, "example") {
+			t.Errorf("Expected masked username, got %q", logged[0].maskedKey)
+		}
+		if !errors.Is(logged[0].err, ErrBasicAuthInvalid) {
+			t.Errorf("Expected ErrBasicAuthInvalid, got %v", logged[0].err)
+		}
+
+		// Missing credentials are reported with an empty key
+		logged = nil
+		app.Test(httptest.NewRequest("GET", "/test", nil))
+		if len(logged) != 1 || logged[0].maskedKey != "" {
+			t.Errorf("Expected one failure with empty key, got %+v", logged)
+		}
+	})
+
+	t.Run("missing_key", func(t *testing.T) {
+		logged = nil
+
+		app := fiber.New()
+		app.Use(NewHeaderAuth(HeaderAuthConfig{KeyProvider: keyProvider, FailureLogger: logger}).Middleware())
+		app.Get("/test", func(c *fiber.Ctx) error {
+			return c.SendString("ok")
+		})
+
+		resp, _ := app.Test(httptest.NewRequest("GET", "/test", nil))
+		if resp.StatusCode != fiber.StatusUnauthorized {
+			t.Errorf("Expected status 401, got %d", resp.StatusCode)
+		}
+		if len(logged) != 1 || logged[0].maskedKey != "" {
+			t.Errorf("Expected one failure with empty key, got %+v", logged)
+		}
+	})
+
+	t.Run("custom_error_handler_still_called", func(t *testing.T) {
+		errCustom := errors.New("custom")
+		app := fiber.New()
+		app.Use(NewHeaderAuth(HeaderAuthConfig{
+			KeyProvider:   keyProvider,
+			FailureLogger: logger,
+			ErrorHandler: func(c *fiber.Ctx, err error) error {
+				return c.Status(fiber.StatusForbidden).SendString(errCustom.Error())
+			},
+		}).Middleware())
+		app.Get("/test", func(c *fiber.Ctx) error {
+			return c.SendString("ok")
+		})
+
+		resp, _ := app.Test(httptest.NewRequest("GET", "/test", nil))
+		if resp.StatusCode != fiber.StatusForbidden {
+			t.Errorf("Expected custom status 403, got %d", resp.StatusCode)
+		}
+	})
+}
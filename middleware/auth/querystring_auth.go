@@ -18,6 +18,22 @@ type QueryStringAuthConfig struct {
 
 	// function called if the key is invalid or missing
 	ErrorHandler fiber.ErrorHandler
+
+	// IPResolver returns the IP allowlist (exact addresses or CIDR ranges) for a valid key.
+	// Requests from other IPs get 403 Forbidden; keys with no allowlist are unrestricted.
+	IPResolver IPResolver
+
+	// FailureLogger is called when authentication fails, with the key masked by MaskKey.
+	FailureLogger FailureLogger
+
+	// Filter defines a function to skip this middleware when it returns true,
+	// e.g. for health checks or metrics endpoints. Checked before SkipPaths.
+	Filter func(c *fiber.Ctx) bool
+
+	// SkipPaths lists paths that bypass authentication.
+	// Entries are anchored: "/health" matches only that path, while a trailing "*"
+	// matches a prefix (e.g., "/public/*" matches "/public" and everything below it).
+	SkipPaths []string
 }
 
 type QueryStringAuth struct {
@@ -42,12 +58,20 @@ func (qsa *QueryStringAuth) SetParamName(name string) {
 // Middleware returns the Fiber middleware handler for Query String Authentication.
 func (qsa *QueryStringAuth) Middleware() fiber.Handler {
 	return keyauth.New(keyauth.Config{
+		// Let exempted paths through without authentication
+		Next: func(c *fiber.Ctx) bool {
+			return shouldSkip(c, qsa.config.Filter, qsa.config.SkipPaths)
+		},
+
 		// Define where to look for the key: "query:access-token" looks for ?access-token=...
 		KeyLookup: "query:" + qsa.config.ParamName,
 
 		// Define the function to validate the extracted key
 		Validator: func(c *fiber.Ctx, key string) (bool, error) {
 			if qsa.config.KeyProvider.IsExists(key) {
+				if err := checkIPAllowed(c, qsa.config.IPResolver, key); err != nil {
+					return false, err
+				}
 				if qsa.config.SuccessHandler != nil {
 					// Call the custom valid function
 					if err := (*qsa.config.SuccessHandler)(c, key); err != nil {
@@ -61,7 +85,9 @@ func (qsa *QueryStringAuth) Middleware() fiber.Handler {
 			return false, keyauth.ErrMissingOrMalformedAPIKey
 		},
 
-		// Optional: Error handler for invalid/missing keys
-		ErrorHandler: qsa.config.ErrorHandler,
+		// Optional: Error handler for invalid/missing keys, reporting failures to FailureLogger
+		ErrorHandler: keyauthErrorHandler(qsa.config.ErrorHandler, qsa.config.FailureLogger, func(c *fiber.Ctx) string {
+			return c.Query(qsa.config.ParamName)
+		}),
 	})
 }
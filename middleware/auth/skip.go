@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// shouldSkip reports whether the auth middleware should let the request through
+// unauthenticated: either filter returns true or the path matches one of the skip patterns.
+func shouldSkip(c *fiber.Ctx, filter func(c *fiber.Ctx) bool, skipPaths []string) bool {
+	if filter != nil && filter(c) {
+		return true
+	}
+	if len(skipPaths) == 0 {
+		return false
+	}
+
+	path := c.Path()
+	for _, pattern := range skipPaths {
+		if matchSkipPath(path, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchSkipPath matches path against an anchored skip pattern:
+//   - "/health" matches only "/health" (a trailing slash is ignored)
+//   - "/public/*" matches "/public" and everything below it, but not "/publicity"
+//   - "/api*" matches every path starting with "/api"
+func matchSkipPath(path, pattern string) bool {
+	if base, ok := strings.CutSuffix(pattern, "/*"); ok {
+		return path == base || strings.HasPrefix(path, base+"/")
+	}
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(path, prefix)
+	}
+	if len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+	if len(pattern) > 1 {
+		pattern = strings.TrimSuffix(pattern, "/")
+	}
+	return path == pattern
+}
@@ -0,0 +1,117 @@
+package auth
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestMatchSkipPath(t *testing.T) {
+	tests := []struct {
+		path    string
+		pattern string
+		want    bool
+	}{
+		{"/health", "/health", true},
+		{"/health/", "/health", true},
+		{"/health", "/health/", true},
+		{"/healthz", "/health", false},
+		{"/api/health", "/health", false},
+		{"/public", "/public/*", true},
+		{"/public/css/app.css", "/public/*", true},
+		{"/publicity", "/public/*", false},
+		{"/private/public/x", "/public/*", false},
+		{"/api/v1/users", "/api*", true},
+		{"/apidocs", "/api*", true},
+		{"/v1/api", "/api*", false},
+		{"/anything", "*", true},
+		{"/", "/", true},
+	}
+
+	for _, tt := range tests {
+		if got := matchSkipPath(tt.path, tt.pattern); got != tt.want {
+			t.Errorf("matchSkipPath(%q, %q) = %v, want %v", tt.path, tt.pattern, got, tt.want)
+		}
+	}
+}
+
+func TestSkipPaths_Middlewares(t *testing.T) {
+	skipPaths := []string{"/health", "/public/*"}
+	keyProvider := NewBaseKeyProvider()
+
+	middlewares := map[string]fiber.Handler{
+		"jwt":         NewJWTAuth(JWTConfig{SecretKey: "secret", SkipPaths: skipPaths}).Middleware(),
+		"basic":       NewBasicAuth(BasicAuthConfig{KeyProvider: keyProvider, SkipPaths: skipPaths}).Middleware(),
+		"header":      NewHeaderAuth(HeaderAuthConfig{KeyProvider: keyProvider, SkipPaths: skipPaths}).Middleware(),
+		"querystring": NewDefaultQueryStringAuth(QueryStringAuthConfig{KeyProvider: keyProvider, SkipPaths: skipPaths}).Middleware(),
+	}
+
+	for name, middleware := range middlewares {
+		t.Run(name, func(t *testing.T) {
+			app := fiber.New()
+			app.Use(middleware)
+			app.Get("/*", func(c *fiber.Ctx) error {
+				return c.SendString("ok")
+			})
+
+			for path, wantStatus := range map[string]int{
+				"/health":          fiber.StatusOK,
+				"/public":          fiber.StatusOK,
+				"/public/logo.png": fiber.StatusOK,
+				"/healthz":         fiber.StatusUnauthorized,
+				"/publicity":       fiber.StatusUnauthorized,
+				"/api/users":       fiber.StatusUnauthorized,
+			} {
+				resp, err := app.Test(httptest.NewRequest("GET", path, nil))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if resp.StatusCode != wantStatus {
+					t.Errorf("%s: expected status %d, got %d", path, wantStatus, resp.StatusCode)
+				}
+			}
+		})
+	}
+}
+
+func TestFilter_Middlewares(t *testing.T) {
+	filter := func(c *fiber.Ctx) bool {
+		return c.Path() == "/metrics" || c.Get("X-Internal") == "true"
+	}
+	keyProvider := NewBaseKeyProvider()
+
+	middlewares := map[string]fiber.Handler{
+		"jwt":         NewJWTAuth(JWTConfig{SecretKey: "secret", Filter: filter}).Middleware(),
+		"basic":       NewBasicAuth(BasicAuthConfig{KeyProvider: keyProvider, Filter: filter}).Middleware(),
+		"header":      NewHeaderAuth(HeaderAuthConfig{KeyProvider: keyProvider, Filter: filter}).Middleware(),
+		"querystring": NewDefaultQueryStringAuth(QueryStringAuthConfig{KeyProvider: keyProvider, Filter: filter}).Middleware(),
+	}
+
+	for name, middleware := range middlewares {
+		t.Run(name, func(t *testing.T) {
+			app := fiber.New()
+			app.Use(middleware)
+			app.Get("/*", func(c *fiber.Ctx) error {
+				return c.SendString("ok")
+			})
+
+			resp, _ := app.Test(httptest.NewRequest("GET", "/metrics", nil))
+			if resp.StatusCode != fiber.StatusOK {
+				t.Errorf("/metrics: expected status 200, got %d", resp.StatusCode)
+			}
+
+			req := httptest.NewRequest("GET", "/api/users", nil)
+			req.Header.Set("X-Internal", "true")
+			resp, _ = app.Test(req)
+			if resp.StatusCode != fiber.StatusOK {
+				t.Errorf("internal request: expected status 200, got %d", resp.StatusCode)
+			}
+
+			resp, _ = app.Test(httptest.NewRequest("GET", "/api/users", nil))
+			if resp.StatusCode != fiber.StatusUnauthorized {
+				t.Errorf("/api/users: expected status 401, got %d", resp.StatusCode)
+			}
+		})
+	}
+}
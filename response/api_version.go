@@ -0,0 +1,139 @@
+package response
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	// apiVersionLocalKey is the context locals key holding the resolved API version
+	apiVersionLocalKey = "api_version"
+
+	// apiVersionMetaLocalKey marks that the API version should be added to the meta envelope
+	apiVersionMetaLocalKey = "api_version_in_meta"
+)
+
+// apiVersionPattern matches a version path segment such as "v1" or "v2"
+var apiVersionPattern = regexp.MustCompile(`^v[0-9]+$`)
+
+// APIVersionConfig defines the configuration for APIVersionMiddleware.
+//
+// Fields:
+//   - SupportedVersions: List of accepted versions (e.g., ["v1", "v2"])
+//   - DefaultVersion: Version used when the request specifies none (optional)
+//   - HeaderName: Request header carrying the version (default: "Accept-Version")
+//   - IncludeInMeta: If true, response helpers add "api_version" to the meta envelope
+type APIVersionConfig struct {
+	SupportedVersions []string
+	DefaultVersion    string
+	HeaderName        string
+	IncludeInMeta     bool
+}
+
+// APIVersionMiddleware creates a Fiber middleware that resolves the API version of each request.
+// The version is extracted in priority order:
+// 1. Path prefix (e.g., /v2/users -> "v2")
+// 2. Version header (e.g., Accept-Version: v2 or Accept-Version: 2)
+// 3. DefaultVersion from config
+//
+// The resolved version is stored in context locals (see GetAPIVersion) and echoed back in the
+// "API-Version" response header. Unsupported versions are rejected with 400 Bad Request.
+// If no version is found and DefaultVersion is empty, the request proceeds without a version.
+//
+// Parameters:
+//   - config: APIVersionConfig - Supported versions and extraction settings
+//
+// Returns:
+//   - fiber.Handler: Middleware function to be used with Fiber app
+//
+// Example:
+//
+//	app.Use(response.APIVersionMiddleware(response.APIVersionConfig{
+//	    SupportedVersions: []string{"v1", "v2"},
+//	    DefaultVersion:    "v1",
+//	    IncludeInMeta:     true,
+//	}))
+func APIVersionMiddleware(config APIVersionConfig) fiber.Handler {
+	if config.HeaderName == "" {
+		config.HeaderName = "Accept-Version"
+	}
+
+	return func(c *fiber.Ctx) error {
+		version := extractAPIVersion(c, config.HeaderName)
+		if version == "" {
+			version = config.DefaultVersion
+		}
+		if version == "" {
+			return c.Next()
+		}
+
+		supported := false
+		for _, v := range config.SupportedVersions {
+			if v == version {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return BadRequest(c, "Unsupported API version: "+version)
+		}
+
+		c.Locals(apiVersionLocalKey, version)
+		if config.IncludeInMeta {
+			c.Locals(apiVersionMetaLocalKey, true)
+		}
+		c.Set("API-Version", version)
+
+		return c.Next()
+	}
+}
+
+// extractAPIVersion returns the version from the first path segment or the version header.
+// Header values without the "v" prefix (e.g., "2") are normalized to "v2".
+func extractAPIVersion(c *fiber.Ctx, headerName string) string {
+	segment := strings.TrimPrefix(c.Path(), "/")
+	if idx := strings.Index(segment, "/"); idx >= 0 {
+		segment = segment[:idx]
+	}
+	if apiVersionPattern.MatchString(segment) {
+		return segment
+	}
+
+	header := strings.TrimSpace(c.Get(headerName))
+	if header == "" {
+		return ""
+	}
+	if !strings.HasPrefix(header, "v") {
+		header = "v" + header
+	}
+	return header
+}
+
+// GetAPIVersion returns the API version resolved by APIVersionMiddleware,
+// or an empty string if the middleware did not set one.
+//
+// Example:
+//
+//	app.Get("/v2/users", func(c *fiber.Ctx) error {
+//	    version := response.GetAPIVersion(c) // "v2"
+//	    ...
+//	})
+func GetAPIVersion(c *fiber.Ctx) string {
+	if version, ok := c.Locals(apiVersionLocalKey).(string); ok {
+		return version
+	}
+	return ""
+}
+
+// decorateMeta adds request-scoped fields to a response meta map.
+// Currently it adds "api_version" when APIVersionMiddleware is configured with IncludeInMeta.
+func decorateMeta(c *fiber.Ctx, meta fiber.Map) fiber.Map {
+	if include, ok := c.Locals(apiVersionMetaLocalKey).(bool); ok && include {
+		if version := GetAPIVersion(c); version != "" {
+			meta["api_version"] = version
+		}
+	}
+	return meta
+}
@@ -0,0 +1,140 @@
+package response
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func setupAPIVersionApp(config APIVersionConfig) *fiber.App {
+	app := fiber.New()
+	app.Use(APIVersionMiddleware(config))
+
+	handler := func(c *fiber.Ctx) error {
+		return Success(c, GetAPIVersion(c), nil)
+	}
+	app.Get("/v1/users", handler)
+	app.Get("/v2/users", handler)
+	app.Get("/v9/users", handler)
+	app.Get("/users", handler)
+
+	return app
+}
+
+func TestAPIVersionMiddleware(t *testing.T) {
+	config := APIVersionConfig{
+		SupportedVersions: []string{"v1", "v2"},
+		IncludeInMeta:     true,
+	}
+
+	t.Run("path_based_version", func(t *testing.T) {
+		app := setupAPIVersionApp(config)
+
+		req := httptest.NewRequest("GET", "/v2/users", nil)
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if resp.StatusCode != 200 {
+			t.Errorf("Expected status 200, got %d", resp.StatusCode)
+		}
+		if resp.Header.Get("API-Version") != "v2" {
+			t.Errorf("Expected API-Version header 'v2', got '%s'", resp.Header.Get("API-Version"))
+		}
+
+		var result map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&result)
+		meta := result["meta"].(map[string]interface{})
+		if meta["message"] != "v2" {
+			t.Errorf("Expected version 'v2' in handler, got %v", meta["message"])
+		}
+		if meta["api_version"] != "v2" {
+			t.Errorf("Expected api_version 'v2' in meta, got %v", meta["api_version"])
+		}
+	})
+
+	t.Run("header_based_version", func(t *testing.T) {
+		app := setupAPIVersionApp(config)
+
+		req := httptest.NewRequest("GET", "/users", nil)
+		req.Header.Set("Accept-Version", "1")
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if resp.StatusCode != 200 {
+			t.Errorf("Expected status 200, got %d", resp.StatusCode)
+		}
+
+		var result map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&result)
+		meta := result["meta"].(map[string]interface{})
+		if meta["api_version"] != "v1" {
+			t.Errorf("Expected api_version 'v1' in meta, got %v", meta["api_version"])
+		}
+	})
+
+	t.Run("path_takes_priority_over_header", func(t *testing.T) {
+		app := setupAPIVersionApp(config)
+
+		req := httptest.NewRequest("GET", "/v2/users", nil)
+		req.Header.Set("Accept-Version", "v1")
+		resp, _ := app.Test(req)
+
+		if resp.Header.Get("API-Version") != "v2" {
+			t.Errorf("Expected API-Version 'v2', got '%s'", resp.Header.Get("API-Version"))
+		}
+	})
+
+	t.Run("unsupported_version", func(t *testing.T) {
+		app := setupAPIVersionApp(config)
+
+		req := httptest.NewRequest("GET", "/v9/users", nil)
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if resp.StatusCode != 400 {
+			t.Errorf("Expected status 400, got %d", resp.StatusCode)
+		}
+
+		var result map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&result)
+		meta := result["meta"].(map[string]interface{})
+		if meta["message"] != "Unsupported API version: v9" {
+			t.Errorf("Expected unsupported version message, got %v", meta["message"])
+		}
+	})
+
+	t.Run("default_version", func(t *testing.T) {
+		defaultConfig := config
+		defaultConfig.DefaultVersion = "v1"
+		app := setupAPIVersionApp(defaultConfig)
+
+		req := httptest.NewRequest("GET", "/users", nil)
+		resp, _ := app.Test(req)
+
+		if resp.Header.Get("API-Version") != "v1" {
+			t.Errorf("Expected API-Version 'v1', got '%s'", resp.Header.Get("API-Version"))
+		}
+	})
+
+	t.Run("meta_excluded_by_default", func(t *testing.T) {
+		app := setupAPIVersionApp(APIVersionConfig{SupportedVersions: []string{"v1", "v2"}})
+
+		req := httptest.NewRequest("GET", "/v1/users", nil)
+		resp, _ := app.Test(req)
+
+		var result map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&result)
+		meta := result["meta"].(map[string]interface{})
+		if _, exists := meta["api_version"]; exists {
+			t.Error("Expected api_version to be absent from meta")
+		}
+	})
+}
@@ -0,0 +1,52 @@
+package response
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Cacheable marks the response as cacheable by clients and shared proxies for maxAge.
+// It sets "Cache-Control: public, max-age=<seconds>" and the matching Expires header.
+// Call it before returning Success (or any other response helper).
+//
+// Parameters:
+//   - c: *fiber.Ctx - The Fiber context
+//   - maxAge: time.Duration - How long the response may be cached (rounded down to seconds)
+//
+// Example:
+//
+//	app.Get("/countries", func(c *fiber.Ctx) error {
+//	    response.Cacheable(c, 10*time.Minute)
+//	    return response.Success(c, "OK", countries)
+//	})
+func Cacheable(c *fiber.Ctx, maxAge time.Duration) {
+	seconds := int64(maxAge / time.Second)
+	if seconds < 0 {
+		seconds = 0
+	}
+
+	c.Set(fiber.HeaderCacheControl, "public, max-age="+strconv.FormatInt(seconds, 10))
+	c.Set(fiber.HeaderExpires, time.Now().Add(time.Duration(seconds)*time.Second).UTC().Format(http.TimeFormat))
+}
+
+// NoCache prevents clients and proxies from storing the response, e.g. for user-specific data.
+// It sets "Cache-Control: no-store, no-cache, must-revalidate, max-age=0", "Pragma: no-cache"
+// for HTTP/1.0 caches, and "Expires: 0" so the response is treated as already expired.
+//
+// Parameters:
+//   - c: *fiber.Ctx - The Fiber context
+//
+// Example:
+//
+//	app.Get("/me", func(c *fiber.Ctx) error {
+//	    response.NoCache(c)
+//	    return response.Success(c, "OK", profile)
+//	})
+func NoCache(c *fiber.Ctx) {
+	c.Set(fiber.HeaderCacheControl, "no-store, no-cache, must-revalidate, max-age=0")
+	c.Set(fiber.HeaderPragma, "no-cache")
+	c.Set(fiber.HeaderExpires, "0")
+}
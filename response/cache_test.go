@@ -0,0 +1,58 @@
+package response
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestCacheable(t *testing.T) {
+	app := fiber.New()
+	app.Get("/countries", func(c *fiber.Ctx) error {
+		Cacheable(c, 10*time.Minute)
+		return Success(c, "OK", []string{"ID", "SG"})
+	})
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/countries", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cc := resp.Header.Get("Cache-Control"); cc != "public, max-age=600" {
+		t.Errorf("Expected Cache-Control 'public, max-age=600', got '%s'", cc)
+	}
+
+	expires, err := http.ParseTime(resp.Header.Get("Expires"))
+	if err != nil {
+		t.Fatalf("Expected valid Expires header, got '%s': %v", resp.Header.Get("Expires"), err)
+	}
+	if d := time.Until(expires); d < 9*time.Minute || d > 10*time.Minute {
+		t.Errorf("Expected Expires about 10 minutes from now, got %v", d)
+	}
+}
+
+func TestNoCache(t *testing.T) {
+	app := fiber.New()
+	app.Get("/me", func(c *fiber.Ctx) error {
+		NoCache(c)
+		return Success(c, "OK", nil)
+	})
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/me", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cc := resp.Header.Get("Cache-Control"); cc != "no-store, no-cache, must-revalidate, max-age=0" {
+		t.Errorf("Expected no-store Cache-Control, got '%s'", cc)
+	}
+	if pragma := resp.Header.Get("Pragma"); pragma != "no-cache" {
+		t.Errorf("Expected Pragma 'no-cache', got '%s'", pragma)
+	}
+	if expires := resp.Header.Get("Expires"); expires != "0" {
+		t.Errorf("Expected Expires '0', got '%s'", expires)
+	}
+}
@@ -0,0 +1,83 @@
+package response
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// EnvelopeConfig controls the standard response envelope: its key names and the optional
+// meta fields added to every response. Empty key fields fall back to the default names.
+//
+// Fields:
+//   - MetaKey: Key of the meta object (default: "meta")
+//   - DataKey: Key of the response data (default: "data")
+//   - SuccessKey: Key of the success flag inside meta (default: "success")
+//   - MessageKey: Key of the message inside meta (default: "message")
+//   - IncludeRequestID: Adds "request_id" to meta from the request's locals, when set
+//   - RequestIDLocalsKey: Locals key holding the request ID (default: "request_id";
+//     use "requestid" for Fiber's requestid middleware)
+//   - IncludeTimestamp: Adds the server time to meta as "timestamp" (RFC 3339, UTC)
+type EnvelopeConfig struct {
+	MetaKey    string
+	DataKey    string
+	SuccessKey string
+	MessageKey string
+
+	IncludeRequestID   bool
+	RequestIDLocalsKey string
+	IncludeTimestamp   bool
+}
+
+// Config controls the envelope used by every response helper.
+// Set it once during application startup, before serving requests.
+//
+// Example:
+//
+//	response.Config = response.EnvelopeConfig{
+//	    MetaKey:          "status",
+//	    DataKey:          "payload",
+//	    IncludeTimestamp: true,
+//	}
+//	// {"status": {"success": true, "message": "OK", "timestamp": "2026-10-16T08:00:00Z"}, "payload": {...}}
+var Config = EnvelopeConfig{
+	MetaKey:            "meta",
+	DataKey:            "data",
+	SuccessKey:         "success",
+	MessageKey:         "message",
+	RequestIDLocalsKey: "request_id",
+}
+
+// envelope builds the standard response body using the configured key names.
+// Extra fields (e.g., pagination or validation errors) are added to meta.
+func envelope(c *fiber.Ctx, success bool, message string, extra fiber.Map, data interface{}) fiber.Map {
+	meta := make(fiber.Map, len(extra)+2)
+	for k, v := range extra {
+		meta[k] = v
+	}
+	meta[keyOrDefault(Config.SuccessKey, "success")] = success
+	meta[keyOrDefault(Config.MessageKey, "message")] = message
+
+	if Config.IncludeRequestID {
+		if id := c.Locals(keyOrDefault(Config.RequestIDLocalsKey, "request_id")); id != nil && id != "" {
+			meta["request_id"] = fmt.Sprint(id)
+		}
+	}
+	if Config.IncludeTimestamp {
+		meta["timestamp"] = time.Now().UTC().Format(time.RFC3339)
+	}
+
+	return fiber.Map{
+		keyOrDefault(Config.MetaKey, "meta"): decorateMeta(c, meta),
+		keyOrDefault(Config.DataKey, "data"): data,
+	}
+}
+
+// keyOrDefault returns key, or def if key is empty
+func keyOrDefault(key, def string) string {
+	if key == "" {
+		return def
+	}
+	return key
+}
@@ -0,0 +1,196 @@
+package response
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestEnvelopeConfig(t *testing.T) {
+	saved := Config
+	defer func() { Config = saved }()
+
+	Config = EnvelopeConfig{
+		MetaKey:    "status",
+		DataKey:    "payload",
+		SuccessKey: "ok",
+		MessageKey: "msg",
+	}
+
+	tests := []struct {
+		name    string
+		handler fiber.Handler
+		ok      bool
+		extra   string
+	}{
+		{"success", func(c *fiber.Ctx) error { return Success(c, "Done", fiber.Map{"id": 1}) }, true, ""},
+		{"error", func(c *fiber.Ctx) error { return Error(c, 500, "Failed") }, false, ""},
+		{"bad_request", func(c *fiber.Ctx) error { return BadRequest(c, "Invalid") }, false, ""},
+		{"pagination", func(c *fiber.Ctx) error {
+			return SuccessWithPagination(c, "Done", NewPaginationResult([]int{1}, 1, 1, 10))
+		}, true, "total_page"},
+		{"cursor", func(c *fiber.Ctx) error { return SuccessWithCursor(c, "Done", []int{1}, "n", "", true) }, true, "has_more"},
+		{"validation", func(c *fiber.Ctx) error {
+			return ValidationErrorI18n(c, &mockValidationError{
+				firstMsg:    "email is required",
+				fieldErrors: map[string][]string{"email": {"email is required"}},
+			})
+		}, false, "errors"},
+		{"from_error", func(c *fiber.Ctx) error { return FromError(c, errors.New("boom")) }, false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Get("/test", tt.handler)
+
+			resp, err := app.Test(httptest.NewRequest("GET", "/test", nil))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var result map[string]interface{}
+			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+				t.Fatal(err)
+			}
+			if _, ok := result["meta"]; ok {
+				t.Error("Expected default 'meta' key to be replaced")
+			}
+			if _, ok := result["payload"]; !ok {
+				t.Error("Expected 'payload' key")
+			}
+
+			status, ok := result["status"].(map[string]interface{})
+			if !ok {
+				t.Fatalf("Expected 'status' object, got %v", result)
+			}
+			if status["ok"] != tt.ok {
+				t.Errorf("Expected ok=%v, got %v", tt.ok, status["ok"])
+			}
+			if _, ok := status["msg"]; !ok {
+				t.Error("Expected 'msg' key in status")
+			}
+			if _, ok := status["success"]; ok {
+				t.Error("Expected default 'success' key to be replaced")
+			}
+			if tt.extra != "" {
+				if _, ok := status[tt.extra]; !ok {
+					t.Errorf("Expected '%s' in status", tt.extra)
+				}
+			}
+		})
+	}
+}
+
+func TestEnvelopeConfig_EmptyKeysUseDefaults(t *testing.T) {
+	saved := Config
+	defer func() { Config = saved }()
+
+	Config = EnvelopeConfig{DataKey: "payload"}
+
+	app := fiber.New()
+	app.Get("/test", func(c *fiber.Ctx) error {
+		return Success(c, "Done", nil)
+	})
+
+	resp, _ := app.Test(httptest.NewRequest("GET", "/test", nil))
+	var result map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&result)
+
+	meta, ok := result["meta"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("Expected default 'meta' key, got %v", result)
+	}
+	if meta["success"] != true || meta["message"] != "Done" {
+		t.Errorf("Expected default meta keys, got %v", meta)
+	}
+	if _, ok := result["payload"]; !ok {
+		t.Error("Expected 'payload' key")
+	}
+}
+
+func TestEnvelopeConfig_RequestIDAndTimestamp(t *testing.T) {
+	saved := Config
+	defer func() { Config = saved }()
+
+	get := func(t *testing.T, handler fiber.Handler) map[string]interface{} {
+		t.Helper()
+		app := fiber.New()
+		app.Get("/test", handler)
+		resp, err := app.Test(httptest.NewRequest("GET", "/test", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var result map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatal(err)
+		}
+		return result["meta"].(map[string]interface{})
+	}
+
+	t.Run("disabled_by_default", func(t *testing.T) {
+		Config = saved
+		meta := get(t, func(c *fiber.Ctx) error {
+			c.Locals("request_id", "req-1")
+			return Success(c, "OK", nil)
+		})
+		if _, ok := meta["request_id"]; ok {
+			t.Error("Expected no request_id when disabled")
+		}
+		if _, ok := meta["timestamp"]; ok {
+			t.Error("Expected no timestamp when disabled")
+		}
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		Config = saved
+		Config.IncludeRequestID = true
+		Config.IncludeTimestamp = true
+
+		before := time.Now().UTC().Add(-time.Second)
+		meta := get(t, func(c *fiber.Ctx) error {
+			c.Locals("request_id", "req-1")
+			return Error(c, 500, "Failed")
+		})
+		if meta["request_id"] != "req-1" {
+			t.Errorf("Expected request_id 'req-1', got %v", meta["request_id"])
+		}
+		ts, err := time.Parse(time.RFC3339, meta["timestamp"].(string))
+		if err != nil {
+			t.Fatalf("Expected RFC 3339 timestamp, got %v", meta["timestamp"])
+		}
+		if ts.Before(before) || ts.After(time.Now().Add(time.Second)) {
+			t.Errorf("Expected current time, got %v", ts)
+		}
+	})
+
+	t.Run("custom_locals_key", func(t *testing.T) {
+		Config = saved
+		Config.IncludeRequestID = true
+		Config.RequestIDLocalsKey = "requestid"
+
+		meta := get(t, func(c *fiber.Ctx) error {
+			c.Locals("requestid", "abc-123")
+			return Success(c, "OK", nil)
+		})
+		if meta["request_id"] != "abc-123" {
+			t.Errorf("Expected request_id 'abc-123', got %v", meta["request_id"])
+		}
+	})
+
+	t.Run("missing_request_id", func(t *testing.T) {
+		Config = saved
+		Config.IncludeRequestID = true
+
+		meta := get(t, func(c *fiber.Ctx) error {
+			return Success(c, "OK", nil)
+		})
+		if _, ok := meta["request_id"]; ok {
+			t.Errorf("Expected no request_id without locals, got %v", meta["request_id"])
+		}
+	})
+}
@@ -2,42 +2,144 @@ package response
 
 import (
 	"errors"
+	"sync"
 
+	"github.com/budimanlai/go-pkg/middleware/auth"
+	"github.com/budimanlai/go-pkg/storage"
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"gorm.io/gorm"
 )
 
+// errorMapping maps errors matching target to a status code and message ID
+type errorMapping struct {
+	target    error
+	status    int
+	messageID string
+}
+
+var (
+	// errorMappingsMu guards errorMappings against registration while handling errors
+	errorMappingsMu sync.RWMutex
+
+	// errorMappings holds the built-in not-found mappings followed by the ones registered
+	// with RegisterErrorMapping, in registration order
+	errorMappings = defaultErrorMappings()
+)
+
+// defaultErrorMappings returns the mappings available without any registration: the
+// not-found errors of gorm and of this module's packages respond with 404
+func defaultErrorMappings() []errorMapping {
+	return []errorMapping{
+		{target: gorm.ErrRecordNotFound, status: fiber.StatusNotFound},
+		{target: auth.ErrKeyNotFound, status: fiber.StatusNotFound},
+		{target: storage.ErrObjectNotFound, status: fiber.StatusNotFound},
+	}
+}
+
+// RegisterErrorMapping makes FiberErrorHandler respond with the given status code and
+// translated message ID for errors matching target (checked with errors.Is, so wrapped
+// errors match too). Registering the same target again replaces its mapping, which
+// also overrides the built-in 404 mappings of gorm.ErrRecordNotFound,
+// auth.ErrKeyNotFound and storage.ErrObjectNotFound.
+// Register mappings during application startup.
+//
+// Parameters:
+//   - target: Sentinel error to match (e.g., gorm.ErrRecordNotFound)
+//   - status: HTTP status code of the response
+//   - messageID: Message identifier to translate; if empty, the standard status text is used
+//
+// Example:
+//
+//	response.RegisterErrorMapping(gorm.ErrRecordNotFound, fiber.StatusNotFound, "record_not_found")
+//	response.RegisterErrorMapping(ErrInsufficientBalance, fiber.StatusUnprocessableEntity, "insufficient_balance")
+func RegisterErrorMapping(target error, status int, messageID string) {
+	errorMappingsMu.Lock()
+	defer errorMappingsMu.Unlock()
+
+	for i, m := range errorMappings {
+		if m.target == target {
+			errorMappings[i] = errorMapping{target: target, status: status, messageID: messageID}
+			return
+		}
+	}
+	errorMappings = append(errorMappings, errorMapping{target: target, status: status, messageID: messageID})
+}
+
+// lookupErrorMapping returns the first registered mapping matching err
+func lookupErrorMapping(err error) (errorMapping, bool) {
+	errorMappingsMu.RLock()
+	defer errorMappingsMu.RUnlock()
+
+	for _, m := range errorMappings {
+		if errors.Is(err, m.target) {
+			return m, true
+		}
+	}
+	return errorMapping{}, false
+}
+
 // FiberErrorHandler is a custom error handler for Fiber framework that processes errors
 // and returns internationalized error responses.
 //
-// It handles different HTTP status codes and returns appropriate i18n error responses:
+// The status code and message ID are resolved in this order:
+//   - Errors matching a mapping registered with RegisterErrorMapping (or a built-in one):
+//     the mapped status and message ID, or the standard status text when the message ID is empty
+//   - *fiber.Error: the error's status code, with its message used as the message ID
+//   - Other errors: 500 (Internal Server Error), with the error's message used as the message ID
+//
+// The message ID is translated into the language set by I18nMiddleware when the i18n
+// manager has a matching key; otherwise it is returned as is:
 //   - 404 (Not Found): Returns NotFoundI18n response
 //   - 400 (Bad Request): Returns BadRequestI18n response
 //   - Other status codes: Returns ErrorI18n response with the corresponding status code
 //
-// If the error is a *fiber.Error, it uses the error's status code.
-// Otherwise, it defaults to 500 (Internal Server Error).
-//
 // Parameters:
 //   - ctx: The Fiber context containing the request/response data
 //   - err: The error to be handled and formatted
 //
 // Returns:
 //   - error: An internationalized error response based on the status code
+//
+// Example:
+//
+//	app := fiber.New(fiber.Config{
+//	    ErrorHandler: response.FiberErrorHandler,
+//	})
+//	app.Use(i18n.I18nMiddleware(i18nMgr))
+//
+//	app.Get("/users/:id", func(c *fiber.Ctx) error {
+//	    return fiber.NewError(fiber.StatusNotFound, "user_not_found") // translated
+//	})
 func FiberErrorHandler(ctx *fiber.Ctx, err error) error {
 	// Status code defaults to 500
 	code := fiber.StatusInternalServerError
+	messageID := err.Error()
 
 	// Retrieve the custom status code if it's a *fiber.Error
 	var e *fiber.Error
-	if errors.As(err, &e) {
+	if m, ok := lookupErrorMapping(err); ok {
+		code = m.status
+		messageID = m.messageID
+		if messageID == "" {
+			messageID = utils.StatusMessage(code)
+		}
+	} else if errors.As(err, &e) {
 		code = e.Code
+		messageID = e.Message
 	}
+
+	return errorResponseI18n(ctx, code, messageID)
+}
+
+// errorResponseI18n writes the translated error response for the status code
+func errorResponseI18n(c *fiber.Ctx, code int, messageID string) error {
 	switch code {
 	case fiber.StatusNotFound:
-		return NotFoundI18n(ctx, err.Error())
+		return NotFoundI18n(c, messageID)
 	case fiber.StatusBadRequest:
-		return BadRequestI18n(ctx, err.Error(), nil)
+		return BadRequestI18n(c, messageID, nil)
 	default:
-		return ErrorI18n(ctx, code, err.Error(), nil)
+		return ErrorI18n(c, code, messageID, nil)
 	}
 }
@@ -0,0 +1,70 @@
+package response
+
+import (
+	"errors"
+
+	"github.com/budimanlai/go-pkg/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+)
+
+// FromError writes the response matching the type of the given error.
+// It centralizes error-to-response mapping so handlers can simply return FromError(c, err).
+// The error's own text is never sent for mapped or unknown errors, since wrapped errors
+// often carry internal details such as object keys or storage paths.
+//
+// Mapping:
+//   - Validation errors (e.g., *validator.ValidationError): validation envelope with field errors (400)
+//   - Errors matching a mapping registered with RegisterErrorMapping: the mapped status and
+//     translated message ID (the standard status text when the message ID is empty)
+//   - gorm.ErrRecordNotFound, auth.ErrKeyNotFound and storage.ErrObjectNotFound:
+//     404 Not Found, unless registered otherwise
+//   - *fiber.Error: its status code and message
+//   - Any other error: 500 Internal Server Error (the original error is logged, not exposed)
+//
+// Wrapped errors are recognized through errors.Is and errors.As.
+//
+// Parameters:
+//   - c: *fiber.Ctx - The Fiber context
+//   - err: error - The error to convert into a response
+//
+// Returns:
+//   - error: Fiber error for response handling
+//
+// Example:
+//
+//	// At startup, for domain errors (not-found errors are mapped to 404 already)
+//	response.RegisterErrorMapping(ErrInsufficientBalance, fiber.StatusUnprocessableEntity, "insufficient_balance")
+//
+//	user, err := userService.Find(id) // gorm.ErrRecordNotFound -> 404 "Not Found"
+//	if err != nil {
+//	    return response.FromError(c, err)
+//	}
+func FromError(c *fiber.Ctx, err error) error {
+	type validationError interface {
+		error
+		First() string
+		GetFieldErrors() map[string][]string
+	}
+
+	var verr validationError
+	if errors.As(err, &verr) {
+		return ValidationErrorI18n(c, verr)
+	}
+
+	if m, ok := lookupErrorMapping(err); ok {
+		messageID := m.messageID
+		if messageID == "" {
+			messageID = utils.StatusMessage(m.status)
+		}
+		return errorResponseI18n(c, m.status, messageID)
+	}
+
+	var ferr *fiber.Error
+	if errors.As(err, &ferr) {
+		return Error(c, ferr.Code, ferr.Message)
+	}
+
+	logger.Errorf("unhandled error on %s %s: %v", c.Method(), c.Path(), err)
+	return Error(c, fiber.StatusInternalServerError, "Internal server error")
+}
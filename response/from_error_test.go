@@ -0,0 +1,161 @@
+package response
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/budimanlai/go-pkg/middleware/auth"
+	"github.com/budimanlai/go-pkg/storage"
+	"github.com/budimanlai/go-pkg/validator"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+var (
+	errTestRecordNotFound = errors.New("record not found")
+	errTestObjectNotFound = errors.New("object not found")
+	errTestConflict       = errors.New("conflict")
+)
+
+// saveErrorMappings returns a copy of the current mappings for restoreErrorMappings
+func saveErrorMappings() []errorMapping {
+	errorMappingsMu.RLock()
+	defer errorMappingsMu.RUnlock()
+	return append([]errorMapping(nil), errorMappings...)
+}
+
+// restoreErrorMappings puts back the mappings returned by saveErrorMappings
+func restoreErrorMappings(saved []errorMapping) {
+	errorMappingsMu.Lock()
+	defer errorMappingsMu.Unlock()
+	errorMappings = saved
+}
+
+func TestFromError(t *testing.T) {
+	type User struct {
+		Email string `json:"email" validate:"required"`
+	}
+
+	tests := []struct {
+		name            string
+		err             error
+		expectedStatus  int
+		expectedMessage string
+	}{
+		{
+			name:            "validation_error",
+			err:             validator.ValidateStruct(User{}),
+			expectedStatus:  fiber.StatusBadRequest,
+			expectedMessage: "email is required",
+		},
+		{
+			name:            "mapped_error",
+			err:             errTestRecordNotFound,
+			expectedStatus:  fiber.StatusNotFound,
+			expectedMessage: "Record not found",
+		},
+		{
+			name:            "wrapped_mapped_error_hides_details",
+			err:             fmt.Errorf("%w: tenants/acme/invoices/2025-10.pdf", errTestObjectNotFound),
+			expectedStatus:  fiber.StatusNotFound,
+			expectedMessage: "File not found",
+		},
+		{
+			name:            "mapped_error_without_message_id",
+			err:             fmt.Errorf("update order 42: %w", errTestConflict),
+			expectedStatus:  fiber.StatusConflict,
+			expectedMessage: "Conflict",
+		},
+		{
+			name:            "unmapped_error_is_internal",
+			err:             fmt.Errorf("read s3://bucket/secret-key: %w", errors.New("object not found")),
+			expectedStatus:  fiber.StatusInternalServerError,
+			expectedMessage: "Internal server error",
+		},
+		{
+			name:            "fiber_error",
+			err:             fiber.NewError(fiber.StatusConflict, "Email already exists"),
+			expectedStatus:  fiber.StatusConflict,
+			expectedMessage: "Email already exists",
+		},
+		{
+			name:            "unknown_error",
+			err:             errors.New("connection refused"),
+			expectedStatus:  fiber.StatusInternalServerError,
+			expectedMessage: "Internal server error",
+		},
+	}
+
+	RegisterErrorMapping(errTestRecordNotFound, fiber.StatusNotFound, "Record not found")
+	RegisterErrorMapping(errTestObjectNotFound, fiber.StatusNotFound, "File not found")
+	RegisterErrorMapping(errTestConflict, fiber.StatusConflict, "")
+	defer restoreErrorMappings(saveErrorMappings())
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Get("/test", func(c *fiber.Ctx) error {
+				return FromError(c, tt.err)
+			})
+
+			req := httptest.NewRequest("GET", "/test", nil)
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if resp.StatusCode != tt.expectedStatus {
+				t.Errorf("Expected status %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+
+			var result map[string]interface{}
+			json.NewDecoder(resp.Body).Decode(&result)
+
+			meta := result["meta"].(map[string]interface{})
+			if meta["success"] != false {
+				t.Error("Success should be false")
+			}
+			if meta["message"] != tt.expectedMessage {
+				t.Errorf("Expected message '%s', got %v", tt.expectedMessage, meta["message"])
+			}
+		})
+	}
+}
+
+func TestFromError_BuiltinNotFound(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{"gorm_record_not_found", fmt.Errorf("find user 42: %w", gorm.ErrRecordNotFound)},
+		{"auth_key_not_found", fmt.Errorf("lookup key: %w", auth.ErrKeyNotFound)},
+		{"storage_object_not_found", fmt.Errorf("get avatars/42.png: %w", storage.ErrObjectNotFound)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Get("/test", func(c *fiber.Ctx) error {
+				return FromError(c, tt.err)
+			})
+
+			resp, err := app.Test(httptest.NewRequest("GET", "/test", nil))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != fiber.StatusNotFound {
+				t.Errorf("Expected status %d, got %d", fiber.StatusNotFound, resp.StatusCode)
+			}
+
+			var result map[string]interface{}
+			json.NewDecoder(resp.Body).Decode(&result)
+			meta := result["meta"].(map[string]interface{})
+			if meta["message"] != "Not Found" {
+				t.Errorf("Expected message 'Not Found', got %v", meta["message"])
+			}
+		})
+	}
+}
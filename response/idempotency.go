@@ -0,0 +1,219 @@
+package response
+
+import (
+	"sync"
+	"time"
+
+	"github.com/budimanlai/go-pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// IdempotencyHeader is the request header carrying the client-generated idempotency key.
+const IdempotencyHeader = "Idempotency-Key"
+
+// IdempotencyRecord holds a captured response that can be replayed for a repeated request.
+type IdempotencyRecord struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+// IdempotencyStore defines the storage used by the Idempotency middleware.
+// Implementations are responsible for expiring records after their TTL.
+type IdempotencyStore interface {
+	// Get returns the stored record for the key, if any.
+	Get(key string) (*IdempotencyRecord, bool)
+
+	// Set stores the record under the key.
+	Set(key string, record IdempotencyRecord) error
+
+	// Lock marks the key as in-flight. It returns false if the key is already locked.
+	Lock(key string) bool
+
+	// Unlock releases the in-flight mark for the key.
+	Unlock(key string)
+}
+
+// MemoryIdempotencyStore is an in-memory implementation of IdempotencyStore.
+// Records expire after the configured TTL. Expired records are dropped when read, and
+// Set sweeps out all expired records at most once per TTL, so keys that are never
+// repeated do not accumulate.
+type MemoryIdempotencyStore struct {
+	ttl       time.Duration
+	records   map[string]memoryIdempotencyEntry
+	inFlight  map[string]struct{}
+	nextSweep time.Time
+	mu        sync.Mutex
+}
+
+type memoryIdempotencyEntry struct {
+	record    IdempotencyRecord
+	expiresAt time.Time
+}
+
+// NewMemoryIdempotencyStore creates a new in-memory idempotency store.
+// If ttl is zero or negative, it defaults to 24 hours.
+//
+// Example:
+//
+//	store := response.NewMemoryIdempotencyStore(time.Hour)
+//	app.Use(response.Idempotency(store))
+func NewMemoryIdempotencyStore(ttl time.Duration) IdempotencyStore {
+	if ttl <= 0 {
+		ttl = 24 * time.Hour
+	}
+
+	return &MemoryIdempotencyStore{
+		ttl:       ttl,
+		records:   make(map[string]memoryIdempotencyEntry),
+		inFlight:  make(map[string]struct{}),
+		nextSweep: time.Now().Add(ttl),
+	}
+}
+
+// Get returns the stored record for the key if it exists and has not expired.
+func (s *MemoryIdempotencyStore) Get(key string) (*IdempotencyRecord, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, exists := s.records[key]
+	if !exists {
+		return nil, false
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(s.records, key)
+		return nil, false
+	}
+
+	record := entry.record
+	return &record, true
+}
+
+// Set stores the record under the key with the store's TTL.
+// Once per TTL it also drops every expired record.
+func (s *MemoryIdempotencyStore) Set(key string, record IdempotencyRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if !now.Before(s.nextSweep) {
+		s.sweepLocked(now)
+		s.nextSweep = now.Add(s.ttl)
+	}
+
+	s.records[key] = memoryIdempotencyEntry{
+		record:    record,
+		expiresAt: now.Add(s.ttl),
+	}
+	return nil
+}
+
+// sweepLocked drops expired records; the caller must hold s.mu
+func (s *MemoryIdempotencyStore) sweepLocked(now time.Time) {
+	for key, entry := range s.records {
+		if now.After(entry.expiresAt) {
+			delete(s.records, key)
+		}
+	}
+}
+
+// Lock marks the key as in-flight. It returns false if the key is already locked.
+func (s *MemoryIdempotencyStore) Lock(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.inFlight[key]; exists {
+		return false
+	}
+	s.inFlight[key] = struct{}{}
+	return true
+}
+
+// Unlock releases the in-flight mark for the key.
+func (s *MemoryIdempotencyStore) Unlock(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.inFlight, key)
+}
+
+// Idempotency returns a Fiber middleware that enforces idempotency keys on POST and PUT requests.
+//
+// Behavior:
+//   - Requests without the Idempotency-Key header, or with other methods, pass through unchanged
+//   - If a response is stored for the key, it is replayed (status, content type and body)
+//   - If a request with the same key is still in progress, a 409 Conflict response is returned
+//   - Otherwise the request proceeds and its response is stored under the key
+//
+// Keys are scoped by method and path, so the same Idempotency-Key sent to different endpoints
+// never replays another endpoint's response. Responses are only stored when the handler returns
+// no error and the status code is below 500, so a failed request can be retried with the same key.
+// If the store fails to save the response, the error is logged and the response is still sent.
+//
+// Parameters:
+//   - store: IdempotencyStore - Storage for captured responses
+//
+// Returns:
+//   - fiber.Handler: Middleware function to be used with Fiber app
+//
+// Example:
+//
+//	app.Use(response.Idempotency(response.NewMemoryIdempotencyStore(24 * time.Hour)))
+func Idempotency(store IdempotencyStore) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		method := c.Method()
+		if method != fiber.MethodPost && method != fiber.MethodPut {
+			return c.Next()
+		}
+
+		key := c.Get(IdempotencyHeader)
+		if key == "" {
+			return c.Next()
+		}
+		key = method + " " + c.Path() + ":" + key
+
+		if record, ok := store.Get(key); ok {
+			return replayIdempotencyRecord(c, record)
+		}
+
+		if !store.Lock(key) {
+			return Error(c, fiber.StatusConflict, "A request with the same idempotency key is already in progress")
+		}
+		defer store.Unlock(key)
+
+		// Another request may have finished between Get and Lock
+		if record, ok := store.Get(key); ok {
+			return replayIdempotencyRecord(c, record)
+		}
+
+		if err := c.Next(); err != nil {
+			return err
+		}
+
+		status := c.Response().StatusCode()
+		if status >= fiber.StatusInternalServerError {
+			return nil
+		}
+
+		body := make([]byte, len(c.Response().Body()))
+		copy(body, c.Response().Body())
+
+		// The handler already succeeded, so a store failure must not turn it into an error response
+		if err := store.Set(key, IdempotencyRecord{
+			StatusCode:  status,
+			ContentType: string(c.Response().Header.ContentType()),
+			Body:        body,
+		}); err != nil {
+			logger.Errorf("idempotency: failed to store response for %s: %v", key, err)
+		}
+		return nil
+	}
+}
+
+// replayIdempotencyRecord writes a previously stored response to the context.
+func replayIdempotencyRecord(c *fiber.Ctx, record *IdempotencyRecord) error {
+	c.Set("Idempotent-Replayed", "true")
+	if record.ContentType != "" {
+		c.Set(fiber.HeaderContentType, record.ContentType)
+	}
+	return c.Status(record.StatusCode).Send(record.Body)
+}
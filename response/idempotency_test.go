@@ -0,0 +1,221 @@
+package response
+
+import (
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// failingIdempotencyStore wraps an IdempotencyStore and fails every Set
+type failingIdempotencyStore struct {
+	IdempotencyStore
+}
+
+func (failingIdempotencyStore) Set(string, IdempotencyRecord) error {
+	return errors.New("store unavailable")
+}
+
+func setupIdempotencyApp(store IdempotencyStore, calls *int) *fiber.App {
+	app := fiber.New()
+	app.Use(Idempotency(store))
+
+	handler := func(c *fiber.Ctx) error {
+		*calls++
+		return c.Status(fiber.StatusCreated).JSON(fiber.Map{"call": *calls})
+	}
+	app.Post("/orders", handler)
+	app.Get("/orders", handler)
+	app.Post("/payments", handler)
+
+	return app
+}
+
+func TestIdempotency(t *testing.T) {
+	t.Run("first_call_stores_response", func(t *testing.T) {
+		store := NewMemoryIdempotencyStore(time.Minute)
+		calls := 0
+		app := setupIdempotencyApp(store, &calls)
+
+		req := httptest.NewRequest("POST", "/orders", nil)
+		req.Header.Set(IdempotencyHeader, "key-1")
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if resp.StatusCode != fiber.StatusCreated {
+			t.Errorf("Expected status 201, got %d", resp.StatusCode)
+		}
+
+		record, ok := store.Get("POST /orders:key-1")
+		if !ok {
+			t.Fatal("Expected response to be stored")
+		}
+		if record.StatusCode != fiber.StatusCreated {
+			t.Errorf("Expected stored status 201, got %d", record.StatusCode)
+		}
+		if string(record.Body) != `{"call":1}` {
+			t.Errorf("Expected stored body '{\"call\":1}', got '%s'", string(record.Body))
+		}
+	})
+
+	t.Run("replay_on_repeat", func(t *testing.T) {
+		store := NewMemoryIdempotencyStore(time.Minute)
+		calls := 0
+		app := setupIdempotencyApp(store, &calls)
+
+		for i := 0; i < 2; i++ {
+			req := httptest.NewRequest("POST", "/orders", nil)
+			req.Header.Set(IdempotencyHeader, "key-2")
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			body, _ := io.ReadAll(resp.Body)
+			if resp.StatusCode != fiber.StatusCreated {
+				t.Errorf("Expected status 201, got %d", resp.StatusCode)
+			}
+			if string(body) != `{"call":1}` {
+				t.Errorf("Expected body '{\"call\":1}', got '%s'", string(body))
+			}
+			if i == 1 && resp.Header.Get("Idempotent-Replayed") != "true" {
+				t.Error("Expected Idempotent-Replayed header on replay")
+			}
+		}
+
+		if calls != 1 {
+			t.Errorf("Expected handler to be called once, got %d", calls)
+		}
+	})
+
+	t.Run("in_flight_key_returns_conflict", func(t *testing.T) {
+		store := NewMemoryIdempotencyStore(time.Minute)
+		calls := 0
+		app := setupIdempotencyApp(store, &calls)
+
+		store.Lock("POST /orders:key-3")
+		defer store.Unlock("POST /orders:key-3")
+
+		req := httptest.NewRequest("POST", "/orders", nil)
+		req.Header.Set(IdempotencyHeader, "key-3")
+		resp, _ := app.Test(req)
+
+		if resp.StatusCode != fiber.StatusConflict {
+			t.Errorf("Expected status 409, got %d", resp.StatusCode)
+		}
+		if calls != 0 {
+			t.Errorf("Expected handler not to be called, got %d", calls)
+		}
+	})
+
+	t.Run("without_key_passes_through", func(t *testing.T) {
+		store := NewMemoryIdempotencyStore(time.Minute)
+		calls := 0
+		app := setupIdempotencyApp(store, &calls)
+
+		for i := 0; i < 2; i++ {
+			req := httptest.NewRequest("POST", "/orders", nil)
+			app.Test(req)
+		}
+
+		if calls != 2 {
+			t.Errorf("Expected handler to be called twice, got %d", calls)
+		}
+	})
+
+	t.Run("safe_method_is_ignored", func(t *testing.T) {
+		store := NewMemoryIdempotencyStore(time.Minute)
+		calls := 0
+		app := setupIdempotencyApp(store, &calls)
+
+		req := httptest.NewRequest("GET", "/orders", nil)
+		req.Header.Set(IdempotencyHeader, "key-4")
+		app.Test(req)
+
+		if _, ok := store.Get("GET /orders:key-4"); ok {
+			t.Error("Expected GET response not to be stored")
+		}
+	})
+
+	t.Run("key_is_scoped_by_endpoint", func(t *testing.T) {
+		store := NewMemoryIdempotencyStore(time.Minute)
+		calls := 0
+		app := setupIdempotencyApp(store, &calls)
+
+		for _, path := range []string{"/orders", "/payments"} {
+			req := httptest.NewRequest("POST", path, nil)
+			req.Header.Set(IdempotencyHeader, "key-5")
+			resp, _ := app.Test(req)
+
+			if resp.Header.Get("Idempotent-Replayed") != "" {
+				t.Errorf("Expected %s not to replay another endpoint's response", path)
+			}
+		}
+
+		if calls != 2 {
+			t.Errorf("Expected handler to be called for each endpoint, got %d", calls)
+		}
+	})
+
+	t.Run("store_failure_keeps_response", func(t *testing.T) {
+		store := failingIdempotencyStore{NewMemoryIdempotencyStore(time.Minute)}
+		calls := 0
+		app := setupIdempotencyApp(store, &calls)
+
+		req := httptest.NewRequest("POST", "/orders", nil)
+		req.Header.Set(IdempotencyHeader, "key-6")
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode != fiber.StatusCreated {
+			t.Errorf("Expected status 201, got %d", resp.StatusCode)
+		}
+		if string(body) != `{"call":1}` {
+			t.Errorf("Expected body '{\"call\":1}', got '%s'", string(body))
+		}
+	})
+}
+
+func TestMemoryIdempotencyStore_Expiry(t *testing.T) {
+	store := NewMemoryIdempotencyStore(10 * time.Millisecond)
+	store.Set("key", IdempotencyRecord{StatusCode: 200})
+
+	if _, ok := store.Get("key"); !ok {
+		t.Fatal("Expected record to exist before TTL")
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	if _, ok := store.Get("key"); ok {
+		t.Error("Expected record to expire after TTL")
+	}
+}
+
+func TestMemoryIdempotencyStore_SweepsExpired(t *testing.T) {
+	store := NewMemoryIdempotencyStore(10 * time.Millisecond).(*MemoryIdempotencyStore)
+	for _, key := range []string{"a", "b", "c"} {
+		store.Set(key, IdempotencyRecord{StatusCode: 200})
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	// Keys that are never read again are dropped by the next Set after the TTL
+	store.Set("d", IdempotencyRecord{StatusCode: 200})
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if len(store.records) != 1 {
+		t.Errorf("Expected only the new record to remain, got %d records", len(store.records))
+	}
+	if _, ok := store.records["d"]; !ok {
+		t.Error("Expected the new record to be stored")
+	}
+}
@@ -0,0 +1,128 @@
+package response
+
+import (
+	"sort"
+
+	"github.com/budimanlai/go-pkg/validator"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+)
+
+// ProblemContentType is the media type of RFC 7807 problem details documents
+const ProblemContentType = "application/problem+json"
+
+// Problem returns an RFC 7807 problem details response instead of the standard envelope,
+// for API consumers that expect application/problem+json error bodies.
+//
+// Response format:
+//
+//	{
+//	  "type": "about:blank",
+//	  "title": "Not Found",
+//	  "status": 404,
+//	  "detail": "User 42 does not exist",
+//	  "instance": "/users/42"
+//	}
+//
+// Extension members are added next to the standard members; they cannot override
+// "type", "title", "status", "detail" or "instance", except that an extension named
+// "type" replaces the default "about:blank" problem type URI.
+//
+// Parameters:
+//   - c: *fiber.Ctx - The Fiber context
+//   - status: HTTP status code
+//   - title: Short, human-readable summary of the problem type (defaults to the status text)
+//   - detail: Human-readable explanation specific to this occurrence (omitted if empty)
+//   - extensions: Additional members (can be nil)
+//
+// Returns:
+//   - error: Fiber error for response handling
+//
+// Example:
+//
+//	return response.Problem(c, fiber.StatusConflict, "Email taken", "user@example.com is already registered", fiber.Map{
+//	    "type": "https://api.example.com/problems/email-taken",
+//	})
+func Problem(c *fiber.Ctx, status int, title, detail string, extensions map[string]interface{}) error {
+	if title == "" {
+		title = utils.StatusMessage(status)
+	}
+
+	body := make(fiber.Map, len(extensions)+5)
+	for k, v := range extensions {
+		body[k] = v
+	}
+	if _, ok := body["type"]; !ok {
+		body["type"] = "about:blank"
+	}
+	body["title"] = title
+	body["status"] = status
+	body["instance"] = c.OriginalURL()
+	if detail != "" {
+		body["detail"] = detail
+	} else {
+		delete(body, "detail")
+	}
+
+	return c.Status(status).JSON(body, ProblemContentType)
+}
+
+// ProblemFromValidation returns a 400 Bad Request problem details response for a validation error.
+// Field errors are exposed both as the RFC 7807 "invalid-params" extension and as the
+// "errors" map used by ValidationErrorI18n.
+//
+// Response format:
+//
+//	{
+//	  "type": "about:blank",
+//	  "title": "Validation failed",
+//	  "status": 400,
+//	  "detail": "Email is required",
+//	  "instance": "/users",
+//	  "invalid-params": [
+//	    {"name": "Email", "reason": "Email is required"}
+//	  ],
+//	  "errors": {
+//	    "Email": ["Email is required"]
+//	  }
+//	}
+//
+// Parameters:
+//   - c: *fiber.Ctx - The Fiber context
+//   - verr: *validator.ValidationError - The validation error
+//
+// Returns:
+//   - error: Fiber error for response handling
+//
+// Example:
+//
+//	if err := validator.ValidateStructWithContext(c, &req); err != nil {
+//	    if verr, ok := err.(*validator.ValidationError); ok {
+//	        return response.ProblemFromValidation(c, verr)
+//	    }
+//	    return response.Problem(c, fiber.StatusBadRequest, "", err.Error(), nil)
+//	}
+func ProblemFromValidation(c *fiber.Ctx, verr *validator.ValidationError) error {
+	if verr == nil {
+		return Problem(c, fiber.StatusBadRequest, "Validation failed", "", nil)
+	}
+
+	fieldErrors := verr.GetFieldErrors()
+	fields := make([]string, 0, len(fieldErrors))
+	for field := range fieldErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	invalidParams := make([]fiber.Map, 0, len(fieldErrors))
+	for _, field := range fields {
+		for _, reason := range fieldErrors[field] {
+			invalidParams = append(invalidParams, fiber.Map{"name": field, "reason": reason})
+		}
+	}
+
+	return Problem(c, fiber.StatusBadRequest, "Validation failed", verr.First(), fiber.Map{
+		"invalid-params": invalidParams,
+		"errors":         fieldErrors,
+	})
+}
@@ -0,0 +1,110 @@
+package response
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/budimanlai/go-pkg/validator"
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestProblem(t *testing.T) {
+	app := fiber.New()
+	app.Get("/users/:id", func(c *fiber.Ctx) error {
+		return Problem(c, fiber.StatusNotFound, "", "User 42 does not exist", fiber.Map{
+			"type":   "https://api.example.com/problems/not-found",
+			"status": 999, // standard members cannot be overridden
+		})
+	})
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/users/42", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != fiber.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", resp.StatusCode)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != ProblemContentType {
+		t.Errorf("Expected content type %q, got %q", ProblemContentType, ct)
+	}
+
+	var result map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{
+		"type":     "https://api.example.com/problems/not-found",
+		"title":    "Not Found",
+		"status":   float64(404),
+		"detail":   "User 42 does not exist",
+		"instance": "/users/42",
+	}
+	for k, want := range expected {
+		if result[k] != want {
+			t.Errorf("Expected %s=%v, got %v", k, want, result[k])
+		}
+	}
+}
+
+func TestProblemFromValidation(t *testing.T) {
+	type CreateUser struct {
+		Name  string `json:"name" validate:"required"`
+		Email string `json:"email" validate:"required,email"`
+	}
+
+	app := fiber.New()
+	app.Post("/users", func(c *fiber.Ctx) error {
+		err := validator.ValidateStruct(&CreateUser{Email: "not-an-email"})
+		verr, ok := err.(*validator.ValidationError)
+		if !ok {
+			t.Fatalf("Expected *validator.ValidationError, got %T", err)
+		}
+		return ProblemFromValidation(c, verr)
+	})
+
+	resp, err := app.Test(httptest.NewRequest("POST", "/users", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != fiber.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", resp.StatusCode)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != ProblemContentType {
+		t.Errorf("Expected content type %q, got %q", ProblemContentType, ct)
+	}
+
+	var result struct {
+		Type          string              `json:"type"`
+		Title         string              `json:"title"`
+		Status        int                 `json:"status"`
+		Detail        string              `json:"detail"`
+		Instance      string              `json:"instance"`
+		InvalidParams []map[string]string `json:"invalid-params"`
+		Errors        map[string][]string `json:"errors"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Type != "about:blank" || result.Title != "Validation failed" || result.Status != 400 || result.Instance != "/users" {
+		t.Errorf("Unexpected standard members: %+v", result)
+	}
+	if result.Detail == "" {
+		t.Error("Expected detail to carry the first validation message")
+	}
+	if len(result.InvalidParams) != 2 {
+		t.Fatalf("Expected 2 invalid params, got %v", result.InvalidParams)
+	}
+	if result.InvalidParams[0]["name"] != "email" || result.InvalidParams[1]["name"] != "name" {
+		t.Errorf("Expected invalid params sorted by field name, got %v", result.InvalidParams)
+	}
+	for _, p := range result.InvalidParams {
+		if p["reason"] == "" {
+			t.Errorf("Expected a reason for %s", p["name"])
+		}
+	}
+	if len(result.Errors["email"]) != 1 || len(result.Errors["name"]) != 1 {
+		t.Errorf("Expected errors map per field, got %v", result.Errors)
+	}
+}
@@ -13,6 +13,50 @@ type PaginationResult struct {
 	Limit     int   `json:"limit"`
 }
 
+// NewPaginationResult builds a PaginationResult, computing TotalPage as ceil(total/limit).
+// The requested page is kept even when it is past TotalPage (the data is then empty), so
+// clients can tell they went beyond the last page; only pages below 1 are raised to 1.
+// A limit <= 0 is treated as "no limit": all items fit on a single page.
+//
+// Parameters:
+//   - data: Items of the current page
+//   - total: Total number of items across all pages
+//   - page: Requested page number (1-based)
+//   - limit: Items per page
+//
+// Returns:
+//   - PaginationResult: Result ready for SuccessWithPagination
+//
+// Example:
+//
+//	users, total := repo.List(page, limit)
+//	return response.SuccessWithPagination(c, "OK", response.NewPaginationResult(users, total, page, limit))
+func NewPaginationResult(data any, total int64, page, limit int) PaginationResult {
+	if total < 0 {
+		total = 0
+	}
+
+	totalPage := 0
+	if total > 0 {
+		totalPage = 1
+		if limit > 0 {
+			totalPage = int((total + int64(limit) - 1) / int64(limit))
+		}
+	}
+
+	if page < 1 {
+		page = 1
+	}
+
+	return PaginationResult{
+		Data:      data,
+		Total:     total,
+		TotalPage: totalPage,
+		Page:      page,
+		Limit:     limit,
+	}
+}
+
 var (
 	// i18nManager holds the global I18nManager instance for response translations
 	i18nManager *i18n.I18nManager
@@ -49,9 +93,20 @@ func getLanguageFromContext(c *fiber.Ctx) string {
 	return i18nManager.DefaultLanguage // fallback to default language
 }
 
+// translate translates messageID into the request language.
+// If the message has no translation, the raw messageID is returned so placeholder
+// text never leaks into API responses.
+func translate(c *fiber.Ctx, messageID string, data interface{}) string {
+	message, err := i18nManager.TranslateOrError(getLanguageFromContext(c), messageID, data)
+	if err != nil {
+		return messageID
+	}
+	return message
+}
+
 // NotFoundI18n returns a 404 Not Found response with a translated message.
 // The message is translated based on the language from the request context.
-// If i18nManager is not set, it falls back to using the messageID as the message.
+// If i18nManager is not set or the message has no translation, the messageID is used as the message.
 //
 // Parameters:
 //   - c: *fiber.Ctx - The Fiber context
@@ -67,7 +122,7 @@ func NotFoundI18n(c *fiber.Ctx, messageID string) error {
 	if i18nManager == nil {
 		return NotFound(c, messageID)
 	}
-	message := i18nManager.Translate(getLanguageFromContext(c), messageID, nil)
+	message := translate(c, messageID, nil)
 	return NotFound(c, message)
 }
 
@@ -93,11 +148,37 @@ func ErrorI18n(c *fiber.Ctx, code int, messageID string, data interface{}) error
 	if i18nManager == nil {
 		return Error(c, code, messageID)
 	}
-	message := i18nManager.Translate(getLanguageFromContext(c), messageID, data)
+	message := translate(c, messageID, data)
 	return Error(c, code, message)
 
 }
 
+// ErrorCodeI18n returns an error response with a machine-readable error code and a translated message.
+// The code is sent as is, so clients can rely on it whatever the language of the message.
+//
+// Parameters:
+//   - c: *fiber.Ctx - The Fiber context
+//   - httpStatus: HTTP status code
+//   - code: Stable error code (e.g., "INSUFFICIENT_BALANCE")
+//   - messageID: Message identifier to translate
+//   - data: Template data for message interpolation (can be nil)
+//
+// Returns:
+//   - error: Fiber error for response handling
+//
+// Example:
+//
+//	return response.ErrorCodeI18n(c, 422, "INSUFFICIENT_BALANCE", "insufficient_balance", map[string]string{
+//	    "Amount": "Rp 50.000",
+//	})
+func ErrorCodeI18n(c *fiber.Ctx, httpStatus int, code, messageID string, data interface{}) error {
+	if i18nManager == nil {
+		return ErrorWithCode(c, httpStatus, code, messageID)
+	}
+	message := translate(c, messageID, data)
+	return ErrorWithCode(c, httpStatus, code, message)
+}
+
 // BadRequestI18n returns a 400 Bad Request response with a translated message.
 // The message is translated based on the language from the request context.
 // Supports template data for dynamic message interpolation.
@@ -119,7 +200,7 @@ func BadRequestI18n(c *fiber.Ctx, messageID string, data interface{}) error {
 	if i18nManager == nil {
 		return BadRequest(c, messageID)
 	}
-	message := i18nManager.Translate(getLanguageFromContext(c), messageID, data)
+	message := translate(c, messageID, data)
 	return BadRequest(c, message)
 }
 
@@ -144,7 +225,7 @@ func SuccessI18n(c *fiber.Ctx, messageID string, data interface{}) error {
 	if i18nManager == nil {
 		return Success(c, messageID, data)
 	}
-	message := i18nManager.Translate(getLanguageFromContext(c), messageID, nil)
+	message := translate(c, messageID, nil)
 	return Success(c, message, data)
 }
 
@@ -152,10 +233,88 @@ func SuccessWithPaginationI18n(c *fiber.Ctx, messageID string, data PaginationRe
 	if i18nManager == nil {
 		return Success(c, messageID, data)
 	}
-	message := i18nManager.Translate(getLanguageFromContext(c), messageID, nil)
+	message := translate(c, messageID, nil)
 	return SuccessWithPagination(c, message, data)
 }
 
+// AcceptedI18n returns a 202 Accepted response with a translated message and optional data.
+// The message is translated based on the language from the request context.
+//
+// Parameters:
+//   - c: *fiber.Ctx - The Fiber context
+//   - messageID: Message identifier to translate
+//   - data: Response data to include in the response body (can be nil)
+//
+// Returns:
+//   - error: Fiber error for response handling
+//
+// Example:
+//
+//	return response.AcceptedI18n(c, "export_queued", fiber.Map{"job_id": jobID})
+func AcceptedI18n(c *fiber.Ctx, messageID string, data interface{}) error {
+	if i18nManager == nil {
+		return Accepted(c, messageID, data)
+	}
+	message := translate(c, messageID, nil)
+	return Accepted(c, message, data)
+}
+
+// ForbiddenI18n returns a 403 Forbidden response with a translated message.
+// The message is translated based on the language from the request context.
+//
+// Parameters:
+//   - c: *fiber.Ctx - The Fiber context
+//   - messageID: Message identifier to translate
+//
+// Returns:
+//   - error: Fiber error for response handling
+//
+// Example:
+//
+//	return response.ForbiddenI18n(c, "access_denied")
+func ForbiddenI18n(c *fiber.Ctx, messageID string) error {
+	if i18nManager == nil {
+		return Forbidden(c, messageID)
+	}
+	message := translate(c, messageID, nil)
+	return Forbidden(c, message)
+}
+
+// UnauthorizedI18n returns a 401 Unauthorized response with a translated message.
+// The message is translated based on the language from the request context.
+//
+// Parameters:
+//   - c: *fiber.Ctx - The Fiber context
+//   - messageID: Message identifier to translate
+//
+// Returns:
+//   - error: Fiber error for response handling
+//
+// Example:
+//
+//	return response.UnauthorizedI18n(c, "session_expired")
+func UnauthorizedI18n(c *fiber.Ctx, messageID string) error {
+	if i18nManager == nil {
+		return Unauthorized(c, messageID)
+	}
+	message := translate(c, messageID, nil)
+	return Unauthorized(c, message)
+}
+
+// SuccessWithCursorI18n returns a cursor-paginated 200 OK response with a translated message.
+// See SuccessWithCursor for the response format.
+//
+// Example:
+//
+//	return response.SuccessWithCursorI18n(c, "events_retrieved", events, next, prev, more)
+func SuccessWithCursorI18n(c *fiber.Ctx, messageID string, data any, nextCursor, prevCursor string, hasMore bool) error {
+	if i18nManager == nil {
+		return SuccessWithCursor(c, messageID, data, nextCursor, prevCursor, hasMore)
+	}
+	message := translate(c, messageID, nil)
+	return SuccessWithCursor(c, message, data, nextCursor, prevCursor, hasMore)
+}
+
 // ValidationErrorI18n returns a 400 Bad Request response with validation error details.
 // It extracts field-specific errors from the ValidationError and formats them in a JSON response.
 // If the error is not a ValidationError, it falls back to a generic bad request response.
@@ -194,14 +353,9 @@ func ValidationErrorI18n(c *fiber.Ctx, err error) error {
 	}
 
 	if verr, ok := err.(validationError); ok {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"meta": fiber.Map{
-				"success": false,
-				"message": verr.First(),
-				"errors":  verr.GetFieldErrors(),
-			},
-			"data": nil,
-		})
+		return c.Status(fiber.StatusBadRequest).JSON(envelope(c, false, verr.First(), fiber.Map{
+			"errors": verr.GetFieldErrors(),
+		}, nil))
 	}
 
 	// Fallback if not a validation error
@@ -234,6 +388,58 @@ func NotFound(c *fiber.Ctx, message string) error {
 	return Error(c, fiber.StatusNotFound, message)
 }
 
+// Forbidden returns a 403 Forbidden JSON response with the specified message.
+//
+// Response format:
+//
+//	{
+//	  "meta": {
+//	    "success": false,
+//	    "message": "You do not have access to this resource"
+//	  },
+//	  "data": null
+//	}
+//
+// Parameters:
+//   - c: *fiber.Ctx - The Fiber context
+//   - message: Error message to include in response
+//
+// Returns:
+//   - error: Fiber error for response handling
+//
+// Example:
+//
+//	return response.Forbidden(c, "You do not have access to this resource")
+func Forbidden(c *fiber.Ctx, message string) error {
+	return Error(c, fiber.StatusForbidden, message)
+}
+
+// Unauthorized returns a 401 Unauthorized JSON response with the specified message.
+//
+// Response format:
+//
+//	{
+//	  "meta": {
+//	    "success": false,
+//	    "message": "Invalid or expired token"
+//	  },
+//	  "data": null
+//	}
+//
+// Parameters:
+//   - c: *fiber.Ctx - The Fiber context
+//   - message: Error message to include in response
+//
+// Returns:
+//   - error: Fiber error for response handling
+//
+// Example:
+//
+//	return response.Unauthorized(c, "Invalid or expired token")
+func Unauthorized(c *fiber.Ctx, message string) error {
+	return Error(c, fiber.StatusUnauthorized, message)
+}
+
 // Error returns a JSON error response with the specified status code and message.
 //
 // Response format:
@@ -258,13 +464,38 @@ func NotFound(c *fiber.Ctx, message string) error {
 //
 //	return response.Error(c, 500, "Internal server error")
 func Error(c *fiber.Ctx, code int, message string) error {
-	return c.Status(code).JSON(fiber.Map{
-		"meta": fiber.Map{
-			"success": false,
-			"message": message,
-		},
-		"data": nil,
-	})
+	return c.Status(code).JSON(envelope(c, false, message, nil, nil))
+}
+
+// ErrorWithCode returns a JSON error response with a machine-readable error code next to the message.
+//
+// Response format:
+//
+//	{
+//	  "meta": {
+//	    "success": false,
+//	    "message": "Insufficient balance",
+//	    "code": "INSUFFICIENT_BALANCE"
+//	  },
+//	  "data": null
+//	}
+//
+// Parameters:
+//   - c: *fiber.Ctx - The Fiber context
+//   - httpStatus: HTTP status code
+//   - code: Stable error code (e.g., "INSUFFICIENT_BALANCE")
+//   - message: Error message to include in response
+//
+// Returns:
+//   - error: Fiber error for response handling
+//
+// Example:
+//
+//	return response.ErrorWithCode(c, 422, "INSUFFICIENT_BALANCE", "Insufficient balance")
+func ErrorWithCode(c *fiber.Ctx, httpStatus int, code, message string) error {
+	return c.Status(httpStatus).JSON(envelope(c, false, message, fiber.Map{
+		"code": code,
+	}, nil))
 }
 
 // BadRequest returns a 400 Bad Request JSON response with the specified message.
@@ -290,13 +521,7 @@ func Error(c *fiber.Ctx, code int, message string) error {
 //
 //	return response.BadRequest(c, "Invalid email format")
 func BadRequest(c *fiber.Ctx, message string) error {
-	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-		"meta": fiber.Map{
-			"success": false,
-			"message": message,
-		},
-		"data": nil,
-	})
+	return c.Status(fiber.StatusBadRequest).JSON(envelope(c, false, message, nil, nil))
 }
 
 // Success returns a 200 OK JSON response with the specified message and data.
@@ -328,25 +553,109 @@ func BadRequest(c *fiber.Ctx, message string) error {
 //	    "name": "John Doe",
 //	})
 func Success(c *fiber.Ctx, message string, data interface{}) error {
-	return c.Status(fiber.StatusOK).JSON(fiber.Map{
-		"meta": fiber.Map{
-			"success": true,
-			"message": message,
-		},
-		"data": data,
-	})
+	return c.Status(fiber.StatusOK).JSON(envelope(c, true, message, nil, data))
+}
+
+// Accepted returns a 202 Accepted JSON response for requests queued for asynchronous processing.
+//
+// Response format:
+//
+//	{
+//	  "meta": {
+//	    "success": true,
+//	    "message": "Export queued"
+//	  },
+//	  "data": {
+//	    "job_id": "abc123"
+//	  }
+//	}
+//
+// Parameters:
+//   - c: *fiber.Ctx - The Fiber context
+//   - message: Message to include in response
+//   - data: Response data, e.g. a job ID to poll (can be nil)
+//
+// Returns:
+//   - error: Fiber error for response handling
+//
+// Example:
+//
+//	return response.Accepted(c, "Export queued", fiber.Map{"job_id": jobID})
+func Accepted(c *fiber.Ctx, message string, data interface{}) error {
+	return c.Status(fiber.StatusAccepted).JSON(envelope(c, true, message, nil, data))
+}
+
+// NoContent returns a 204 No Content response with an empty body.
+//
+// Parameters:
+//   - c: *fiber.Ctx - The Fiber context
+//
+// Returns:
+//   - error: Fiber error for response handling
+//
+// Example:
+//
+//	app.Delete("/users/:id", func(c *fiber.Ctx) error {
+//	    // delete the user...
+//	    return response.NoContent(c)
+//	})
+func NoContent(c *fiber.Ctx) error {
+	return c.SendStatus(fiber.StatusNoContent)
 }
 
 func SuccessWithPagination(c *fiber.Ctx, message string, data PaginationResult) error {
-	return c.Status(fiber.StatusOK).JSON(fiber.Map{
-		"meta": fiber.Map{
-			"success":    true,
-			"message":    message,
-			"total":      data.Total,
-			"total_page": data.TotalPage,
-			"page":       data.Page,
-			"limit":      data.Limit,
-		},
-		"data": data.Data,
-	})
+	return c.Status(fiber.StatusOK).JSON(envelope(c, true, message, fiber.Map{
+		"total":      data.Total,
+		"total_page": data.TotalPage,
+		"page":       data.Page,
+		"limit":      data.Limit,
+	}, data.Data))
+}
+
+// SuccessWithCursor returns a 200 OK JSON response for cursor (keyset) pagination.
+// Instead of total/page/limit, meta carries opaque cursors for the next and previous pages;
+// an empty cursor is serialized as null.
+//
+// Response format:
+//
+//	{
+//	  "meta": {
+//	    "success": true,
+//	    "message": "Success message",
+//	    "next_cursor": "eyJpZCI6MTAwfQ",
+//	    "prev_cursor": null,
+//	    "has_more": true
+//	  },
+//	  "data": [...]
+//	}
+//
+// Parameters:
+//   - c: *fiber.Ctx - The Fiber context
+//   - message: Success message to include in response
+//   - data: Items of the current page
+//   - nextCursor: Cursor for the next page (empty if none)
+//   - prevCursor: Cursor for the previous page (empty if none)
+//   - hasMore: Whether more items exist after this page
+//
+// Returns:
+//   - error: Fiber error for response handling
+//
+// Example:
+//
+//	events, next, more := repo.Feed(c.Query("cursor"), 50)
+//	return response.SuccessWithCursor(c, "OK", events, next, c.Query("cursor"), more)
+func SuccessWithCursor(c *fiber.Ctx, message string, data any, nextCursor, prevCursor string, hasMore bool) error {
+	return c.Status(fiber.StatusOK).JSON(envelope(c, true, message, fiber.Map{
+		"next_cursor": cursorValue(nextCursor),
+		"prev_cursor": cursorValue(prevCursor),
+		"has_more":    hasMore,
+	}, data))
+}
+
+// cursorValue returns nil for an empty cursor, so it is serialized as null
+func cursorValue(cursor string) interface{} {
+	if cursor == "" {
+		return nil
+	}
+	return cursor
 }
@@ -3,6 +3,8 @@ package response
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"net/http/httptest"
 	"testing"
 
@@ -285,6 +287,173 @@ func TestNotFound(t *testing.T) {
 	})
 }
 
+func TestNewPaginationResult(t *testing.T) {
+	tests := []struct {
+		name          string
+		total         int64
+		page          int
+		limit         int
+		expectedPages int
+		expectedPage  int
+	}{
+		{"exact_multiple", 30, 2, 10, 3, 2},
+		{"partial_last_page", 31, 4, 10, 4, 4},
+		{"single_item", 1, 1, 10, 1, 1},
+		{"empty", 0, 1, 10, 0, 1},
+		{"zero_limit", 25, 1, 0, 1, 1},
+		{"negative_limit", 25, 1, -5, 1, 1},
+		{"page_below_range", 25, 0, 10, 3, 1},
+		{"page_above_range", 25, 9, 10, 3, 9},
+		{"page_of_empty_result", 0, 2, 10, 0, 2},
+		{"negative_total", -1, 1, 10, 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := NewPaginationResult([]int{}, tt.total, tt.page, tt.limit)
+			if result.TotalPage != tt.expectedPages {
+				t.Errorf("Expected TotalPage %d, got %d", tt.expectedPages, result.TotalPage)
+			}
+			if result.Page != tt.expectedPage {
+				t.Errorf("Expected Page %d, got %d", tt.expectedPage, result.Page)
+			}
+			if result.Limit != tt.limit {
+				t.Errorf("Expected Limit %d, got %d", tt.limit, result.Limit)
+			}
+		})
+	}
+}
+
+func TestSuccessWithCursor(t *testing.T) {
+	app := fiber.New()
+	app.Get("/feed", func(c *fiber.Ctx) error {
+		return SuccessWithCursor(c, "OK", []string{"a", "b"}, "next123", "", true)
+	})
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/feed", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != 200 {
+		t.Errorf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	var result map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatal(err)
+	}
+	meta := result["meta"].(map[string]interface{})
+	if meta["success"] != true || meta["message"] != "OK" {
+		t.Errorf("Unexpected envelope: %v", meta)
+	}
+	if meta["next_cursor"] != "next123" {
+		t.Errorf("Expected next_cursor 'next123', got %v", meta["next_cursor"])
+	}
+	if v, ok := meta["prev_cursor"]; !ok || v != nil {
+		t.Errorf("Expected prev_cursor null, got %v", v)
+	}
+	if meta["has_more"] != true {
+		t.Errorf("Expected has_more true, got %v", meta["has_more"])
+	}
+	for _, key := range []string{"total", "page", "limit", "total_page"} {
+		if _, ok := meta[key]; ok {
+			t.Errorf("Expected no offset field '%s' in cursor meta", key)
+		}
+	}
+	if data := result["data"].([]interface{}); len(data) != 2 {
+		t.Errorf("Expected 2 items, got %v", data)
+	}
+}
+
+func TestStatusHelpers(t *testing.T) {
+	tests := []struct {
+		name           string
+		handler        fiber.Handler
+		expectedStatus int
+		expectedOK     bool
+		expectedMsg    string
+	}{
+		{
+			name:           "accepted",
+			handler:        func(c *fiber.Ctx) error { return Accepted(c, "Export queued", fiber.Map{"job_id": "abc123"}) },
+			expectedStatus: 202,
+			expectedOK:     true,
+			expectedMsg:    "Export queued",
+		},
+		{
+			name:           "forbidden",
+			handler:        func(c *fiber.Ctx) error { return Forbidden(c, "Access denied") },
+			expectedStatus: 403,
+			expectedMsg:    "Access denied",
+		},
+		{
+			name:           "unauthorized",
+			handler:        func(c *fiber.Ctx) error { return Unauthorized(c, "Invalid token") },
+			expectedStatus: 401,
+			expectedMsg:    "Invalid token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Get("/test", tt.handler)
+
+			resp, err := app.Test(httptest.NewRequest("GET", "/test", nil))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != tt.expectedStatus {
+				t.Errorf("Expected status %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+
+			var result map[string]interface{}
+			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+				t.Fatal(err)
+			}
+			meta := result["meta"].(map[string]interface{})
+			if meta["success"] != tt.expectedOK {
+				t.Errorf("Expected success %v, got %v", tt.expectedOK, meta["success"])
+			}
+			if meta["message"] != tt.expectedMsg {
+				t.Errorf("Expected message '%s', got %v", tt.expectedMsg, meta["message"])
+			}
+		})
+	}
+
+	t.Run("accepted_data", func(t *testing.T) {
+		app := fiber.New()
+		app.Get("/test", tests[0].handler)
+
+		resp, _ := app.Test(httptest.NewRequest("GET", "/test", nil))
+		var result map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&result)
+		data := result["data"].(map[string]interface{})
+		if data["job_id"] != "abc123" {
+			t.Errorf("Expected job_id in data, got %v", data)
+		}
+	})
+
+	t.Run("no_content", func(t *testing.T) {
+		app := fiber.New()
+		app.Delete("/test", func(c *fiber.Ctx) error {
+			return NoContent(c)
+		})
+
+		resp, err := app.Test(httptest.NewRequest("DELETE", "/test", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != 204 {
+			t.Errorf("Expected status 204, got %d", resp.StatusCode)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		if len(body) != 0 {
+			t.Errorf("Expected empty body, got %q", body)
+		}
+	})
+}
+
 // ============================================================================
 // I18n Response Tests
 // ============================================================================
@@ -385,6 +554,26 @@ func TestSuccessI18n(t *testing.T) {
 			t.Errorf("Expected Chinese message, got %v", meta["message"])
 		}
 	})
+
+	t.Run("missing_translation_falls_back_to_message_id", func(t *testing.T) {
+		app := fiber.New()
+
+		app.Get("/test", func(c *fiber.Ctx) error {
+			c.Locals("language", "id")
+			return SuccessI18n(c, "Order saved", nil)
+		})
+
+		req := httptest.NewRequest("GET", "/test", nil)
+		resp, _ := app.Test(req)
+
+		var result map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&result)
+		meta := result["meta"].(map[string]interface{})
+
+		if meta["message"] != "Order saved" {
+			t.Errorf("Expected raw message ID, got %v", meta["message"])
+		}
+	})
 }
 
 func TestErrorI18n(t *testing.T) {
@@ -573,6 +762,88 @@ func TestNotFoundI18n(t *testing.T) {
 // ValidationErrorI18n Tests
 // ============================================================================
 
+func TestErrorWithCode(t *testing.T) {
+	setupI18n(t)
+
+	tests := []struct {
+		name        string
+		handler     fiber.Handler
+		expectedMsg string
+	}{
+		{"plain", func(c *fiber.Ctx) error {
+			return ErrorWithCode(c, 422, "INSUFFICIENT_BALANCE", "Insufficient balance")
+		}, "Insufficient balance"},
+		{"i18n_translated", func(c *fiber.Ctx) error {
+			c.Locals("language", "id")
+			return ErrorCodeI18n(c, 422, "INSUFFICIENT_BALANCE", "welcome", nil)
+		}, "Selamat datang di aplikasi kami!"},
+		{"i18n_untranslated", func(c *fiber.Ctx) error {
+			return ErrorCodeI18n(c, 422, "INSUFFICIENT_BALANCE", "insufficient_balance", nil)
+		}, "insufficient_balance"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Get("/test", tt.handler)
+
+			resp, _ := app.Test(httptest.NewRequest("GET", "/test", nil))
+			if resp.StatusCode != 422 {
+				t.Errorf("Expected 422, got %d", resp.StatusCode)
+			}
+
+			var result map[string]interface{}
+			json.NewDecoder(resp.Body).Decode(&result)
+			meta := result["meta"].(map[string]interface{})
+			if meta["code"] != "INSUFFICIENT_BALANCE" {
+				t.Errorf("Expected code 'INSUFFICIENT_BALANCE', got %v", meta["code"])
+			}
+			if meta["message"] != tt.expectedMsg {
+				t.Errorf("Expected message '%s', got %v", tt.expectedMsg, meta["message"])
+			}
+			if meta["success"] != false {
+				t.Error("Success should be false")
+			}
+		})
+	}
+}
+
+func TestStatusHelpersI18n(t *testing.T) {
+	setupI18n(t)
+
+	tests := []struct {
+		name           string
+		handler        fiber.Handler
+		expectedStatus int
+	}{
+		{"accepted", func(c *fiber.Ctx) error { return AcceptedI18n(c, "welcome", nil) }, 202},
+		{"forbidden", func(c *fiber.Ctx) error { return ForbiddenI18n(c, "welcome") }, 403},
+		{"unauthorized", func(c *fiber.Ctx) error { return UnauthorizedI18n(c, "welcome") }, 401},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Get("/test", func(c *fiber.Ctx) error {
+				c.Locals("language", "en")
+				return tt.handler(c)
+			})
+
+			resp, _ := app.Test(httptest.NewRequest("GET", "/test", nil))
+			if resp.StatusCode != tt.expectedStatus {
+				t.Errorf("Expected %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+
+			var result map[string]interface{}
+			json.NewDecoder(resp.Body).Decode(&result)
+			meta := result["meta"].(map[string]interface{})
+			if meta["message"] != "Welcome to our application!" {
+				t.Errorf("Expected translated message, got %v", meta["message"])
+			}
+		})
+	}
+}
+
 // Mock ValidationError for testing
 type mockValidationError struct {
 	firstMsg    string
@@ -787,6 +1058,56 @@ func TestFiberErrorHandler(t *testing.T) {
 	})
 }
 
+func TestFiberErrorHandler_Mappings(t *testing.T) {
+	setupI18n(t)
+	defer restoreErrorMappings(saveErrorMappings())
+
+	errNoBalance := errors.New("insufficient balance")
+	errLocked := errors.New("account locked")
+	RegisterErrorMapping(errNoBalance, fiber.StatusUnprocessableEntity, "welcome")
+	RegisterErrorMapping(errLocked, fiber.StatusForbidden, "")
+	RegisterErrorMapping(errLocked, fiber.StatusLocked, "")
+
+	tests := []struct {
+		name           string
+		lang           string
+		err            error
+		expectedStatus int
+		expectedMsg    string
+	}{
+		{"mapped_translated", "id", errNoBalance, 422, "Selamat datang di aplikasi kami!"},
+		{"mapped_wrapped", "en", fmt.Errorf("charge: %w", errNoBalance), 422, "Welcome to our application!"},
+		{"mapped_without_message_id", "en", errLocked, 423, "Locked"},
+		{"fiber_error_translated", "id", fiber.NewError(fiber.StatusNotFound, "welcome"), 404, "Selamat datang di aplikasi kami!"},
+		{"fiber_error_wrapped", "en", fmt.Errorf("lookup: %w", fiber.NewError(fiber.StatusConflict, "welcome")), 409, "Welcome to our application!"},
+		{"unmapped_generic", "en", errors.New("something broke"), 500, "something broke"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New(fiber.Config{
+				ErrorHandler: FiberErrorHandler,
+			})
+			app.Get("/test", func(c *fiber.Ctx) error {
+				c.Locals("language", tt.lang)
+				return tt.err
+			})
+
+			resp, _ := app.Test(httptest.NewRequest("GET", "/test", nil))
+			if resp.StatusCode != tt.expectedStatus {
+				t.Errorf("Expected %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+
+			var result map[string]interface{}
+			json.NewDecoder(resp.Body).Decode(&result)
+			meta := result["meta"].(map[string]interface{})
+			if meta["message"] != tt.expectedMsg {
+				t.Errorf("Expected message '%s', got %v", tt.expectedMsg, meta["message"])
+			}
+		})
+	}
+}
+
 // ============================================================================
 // Helper Function Tests
 // ============================================================================
@@ -0,0 +1,145 @@
+package response
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// SSEvent is a single Server-Sent Event written by SSE.
+//
+// Fields:
+//   - ID: Optional event ID (sent as "id:"), lets clients resume with Last-Event-ID;
+//     CR and LF characters are removed since they would end the field
+//   - Event: Optional event name (sent as "event:"); clients default to "message";
+//     CR and LF characters are removed like in ID
+//   - Data: Event payload; strings and []byte are sent as-is, other values are JSON-encoded.
+//     Each line (ended by CRLF, CR or LF) is sent as its own "data:" field
+//   - Retry: Optional reconnection delay in milliseconds (sent as "retry:")
+type SSEvent struct {
+	ID    string
+	Event string
+	Data  interface{}
+	Retry int
+}
+
+// SSE streams events from the channel to the client as Server-Sent Events.
+// It sets the text/event-stream headers and writes one frame per event, flushing after each.
+// The stream ends when the channel is closed, the server shuts down, or writing an event
+// fails. fasthttp does not report a client disconnect by itself, so a disconnected client
+// is detected when the next event cannot be written or flushed.
+//
+// The events are written after the handler returns, so the producer must keep sending
+// on the channel (typically from its own goroutine) and close it when done. Once the
+// stream has ended, remaining events are received and discarded until the channel is
+// closed, so the producer never blocks on a disconnected client.
+//
+// Parameters:
+//   - c: *fiber.Ctx - The Fiber context
+//   - events: <-chan SSEvent - Events to send to the client
+//
+// Returns:
+//   - error: Always nil; write errors end the stream
+//
+// Example:
+//
+//	app.Get("/uploads/:id/progress", func(c *fiber.Ctx) error {
+//	    events := make(chan response.SSEvent)
+//	    go func() {
+//	        defer close(events)
+//	        for p := range pipeline.Progress(c.Params("id")) {
+//	            events <- response.SSEvent{Event: "progress", Data: p}
+//	        }
+//	    }()
+//	    return response.SSE(c, events)
+//	})
+func SSE(c *fiber.Ctx, events <-chan SSEvent) error {
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+
+	// Done is closed on server shutdown only, not when the client goes away
+	shutdown := c.Context().Done()
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		streamSSE(w, events, shutdown)
+	})
+
+	return nil
+}
+
+// streamSSE writes events to w until the channel is closed, shutdown is closed, or a write
+// fails. A write or flush error means the client has disconnected. Events left in the channel
+// are then drained in the background, so the producer can finish and close it.
+func streamSSE(w *bufio.Writer, events <-chan SSEvent, shutdown <-chan struct{}) {
+	defer func() {
+		go func() {
+			for range events {
+			}
+		}()
+	}()
+
+	for {
+		select {
+		case <-shutdown:
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			if err := writeSSEvent(w, event); err != nil {
+				return
+			}
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// sseFieldReplacer removes line breaks from single-line fields (id, event)
+var sseFieldReplacer = strings.NewReplacer("\r", "", "\n", "")
+
+// sseLineReplacer normalizes CRLF and CR line endings to LF in data
+var sseLineReplacer = strings.NewReplacer("\r\n", "\n", "\r", "\n")
+
+// writeSSEvent writes a single event frame. Multi-line data is split into several "data:" lines.
+func writeSSEvent(w *bufio.Writer, event SSEvent) error {
+	var data string
+	switch v := event.Data.(type) {
+	case nil:
+		data = ""
+	case string:
+		data = v
+	case []byte:
+		data = string(v)
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		data = string(encoded)
+	}
+
+	var frame strings.Builder
+	if id := sseFieldReplacer.Replace(event.ID); id != "" {
+		fmt.Fprintf(&frame, "id: %s\n", id)
+	}
+	if name := sseFieldReplacer.Replace(event.Event); name != "" {
+		fmt.Fprintf(&frame, "event: %s\n", name)
+	}
+	if event.Retry > 0 {
+		fmt.Fprintf(&frame, "retry: %d\n", event.Retry)
+	}
+	for _, line := range strings.Split(sseLineReplacer.Replace(data), "\n") {
+		fmt.Fprintf(&frame, "data: %s\n", line)
+	}
+	frame.WriteString("\n")
+
+	_, err := w.WriteString(frame.String())
+	return err
+}
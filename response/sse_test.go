@@ -0,0 +1,106 @@
+package response
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestSSE(t *testing.T) {
+	app := fiber.New()
+	app.Get("/events", func(c *fiber.Ctx) error {
+		events := make(chan SSEvent, 3)
+		events <- SSEvent{Event: "progress", Data: map[string]int{"percent": 50}}
+		events <- SSEvent{ID: "2", Event: "done", Data: "line one\nline two", Retry: 3000}
+		events <- SSEvent{Data: []byte("bye")}
+		close(events)
+		return SSE(c, events)
+	})
+
+	req := httptest.NewRequest("GET", "/events", nil)
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("Expected Content-Type 'text/event-stream', got '%s'", ct)
+	}
+	if cc := resp.Header.Get("Cache-Control"); cc != "no-cache" {
+		t.Errorf("Expected Cache-Control 'no-cache', got '%s'", cc)
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+	expected := "event: progress\ndata: {\"percent\":50}\n\n" +
+		"id: 2\nevent: done\nretry: 3000\ndata: line one\ndata: line two\n\n" +
+		"data: bye\n\n"
+	if string(body) != expected {
+		t.Errorf("Unexpected SSE stream:\nexpected %q\ngot      %q", expected, string(body))
+	}
+}
+
+func TestWriteSSEvent_LineBreaks(t *testing.T) {
+	var buf strings.Builder
+	w := bufio.NewWriter(&buf)
+
+	event := SSEvent{
+		ID:    "1\r\ndata: injected",
+		Event: "update\nid: 99",
+		Data:  "crlf\r\ncr\rlf\nend",
+	}
+	if err := writeSSEvent(w, event); err != nil {
+		t.Fatal(err)
+	}
+	w.Flush()
+
+	expected := "id: 1data: injected\nevent: updateid: 99\n" +
+		"data: crlf\ndata: cr\ndata: lf\ndata: end\n\n"
+	if buf.String() != expected {
+		t.Errorf("Unexpected frame:\nexpected %q\ngot      %q", expected, buf.String())
+	}
+}
+
+// failingWriter fails every write, like a connection to a disconnected client
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("connection reset by peer")
+}
+
+func TestStreamSSE_Disconnect(t *testing.T) {
+	events := make(chan SSEvent)
+	stopped := make(chan struct{})
+	go func() {
+		streamSSE(bufio.NewWriter(failingWriter{}), events, nil)
+		close(stopped)
+	}()
+
+	// The first event fails to flush and ends the stream
+	events <- SSEvent{Data: "hello"}
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("Expected stream to stop after a write error")
+	}
+
+	// The producer is not blocked by the disconnected client
+	sent := make(chan struct{})
+	go func() {
+		for i := 0; i < 3; i++ {
+			events <- SSEvent{Data: "more"}
+		}
+		close(events)
+		close(sent)
+	}()
+	select {
+	case <-sent:
+	case <-time.After(time.Second):
+		t.Fatal("Expected remaining events to be drained")
+	}
+}
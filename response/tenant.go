@@ -0,0 +1,107 @@
+package response
+
+import (
+	"strings"
+
+	"github.com/budimanlai/go-pkg/tenant"
+	"github.com/gofiber/fiber/v2"
+)
+
+// TenantConfig defines the configuration for TenantMiddleware.
+//
+// Fields:
+//   - BaseDomain: Domain the tenant subdomain is stripped from (e.g., "api.example.com").
+//     When empty, subdomain extraction is disabled.
+//   - HeaderName: Request header carrying the tenant ID (default: "X-Tenant-ID")
+//   - AllowedTenants: Static allow-list of tenant IDs (optional)
+//   - Resolver: Function reporting whether a tenant ID exists (optional)
+//
+// If neither AllowedTenants nor Resolver is set, any non-empty tenant ID is accepted.
+type TenantConfig struct {
+	BaseDomain     string
+	HeaderName     string
+	AllowedTenants []string
+	Resolver       func(tenantID string) bool
+}
+
+// TenantMiddleware creates a Fiber middleware that resolves the tenant of each request.
+// The tenant ID is extracted in priority order:
+// 1. Subdomain of BaseDomain (e.g., acme.api.example.com -> "acme")
+// 2. Tenant header (e.g., X-Tenant-ID: acme)
+//
+// The tenant ID is validated against AllowedTenants and/or Resolver and stored in
+// context locals (see GetTenantID). Requests without a tenant ID are rejected with
+// 400 Bad Request; unknown tenants are rejected with 404 Not Found.
+//
+// Parameters:
+//   - config: TenantConfig - Extraction and validation settings
+//
+// Returns:
+//   - fiber.Handler: Middleware function to be used with Fiber app
+//
+// Example:
+//
+//	app.Use(response.TenantMiddleware(response.TenantConfig{
+//	    BaseDomain: "api.example.com",
+//	    Resolver: func(tenantID string) bool {
+//	        return tenantRepo.Exists(tenantID)
+//	    },
+//	}))
+func TenantMiddleware(config TenantConfig) fiber.Handler {
+	if config.HeaderName == "" {
+		config.HeaderName = "X-Tenant-ID"
+	}
+	config.BaseDomain = strings.ToLower(strings.TrimPrefix(config.BaseDomain, "."))
+
+	allowed := make(map[string]bool, len(config.AllowedTenants))
+	for _, t := range config.AllowedTenants {
+		allowed[t] = true
+	}
+
+	return func(c *fiber.Ctx) error {
+		tenantID := extractTenantID(c, config.BaseDomain, config.HeaderName)
+		if tenantID == "" {
+			return BadRequest(c, "Tenant ID is required")
+		}
+
+		if len(allowed) > 0 && !allowed[tenantID] {
+			return NotFound(c, "Unknown tenant: "+tenantID)
+		}
+		if config.Resolver != nil && !config.Resolver(tenantID) {
+			return NotFound(c, "Unknown tenant: "+tenantID)
+		}
+
+		tenant.SetID(c, tenantID)
+
+		return c.Next()
+	}
+}
+
+// extractTenantID returns the tenant from the subdomain of baseDomain or the tenant header.
+// Only a single subdomain label is accepted (e.g., "acme.api.example.com", not "a.b.api.example.com").
+func extractTenantID(c *fiber.Ctx, baseDomain, headerName string) string {
+	if baseDomain != "" {
+		host := strings.ToLower(c.Hostname())
+		if idx := strings.LastIndex(host, ":"); idx >= 0 && !strings.Contains(host, "]") {
+			host = host[:idx]
+		}
+		if sub := strings.TrimSuffix(host, "."+baseDomain); sub != host && sub != "" && !strings.Contains(sub, ".") {
+			return sub
+		}
+	}
+
+	return strings.TrimSpace(c.Get(headerName))
+}
+
+// GetTenantID returns the tenant ID resolved by TenantMiddleware,
+// or an empty string if the middleware did not set one. It is the same as tenant.ID.
+//
+// Example:
+//
+//	app.Get("/orders", func(c *fiber.Ctx) error {
+//	    tenantID := response.GetTenantID(c) // "acme"
+//	    ...
+//	})
+func GetTenantID(c *fiber.Ctx) string {
+	return tenant.ID(c)
+}
@@ -0,0 +1,116 @@
+package response
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func setupTenantApp(config TenantConfig) *fiber.App {
+	app := fiber.New()
+	app.Use(TenantMiddleware(config))
+	app.Get("/orders", func(c *fiber.Ctx) error {
+		return Success(c, GetTenantID(c), nil)
+	})
+	return app
+}
+
+func TestTenantMiddleware(t *testing.T) {
+	config := TenantConfig{
+		BaseDomain:     "api.example.com",
+		AllowedTenants: []string{"acme", "globex"},
+	}
+
+	t.Run("subdomain_extraction", func(t *testing.T) {
+		app := setupTenantApp(config)
+
+		req := httptest.NewRequest("GET", "http://acme.api.example.com:8080/orders", nil)
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if resp.StatusCode != 200 {
+			t.Errorf("Expected status 200, got %d", resp.StatusCode)
+		}
+
+		var result map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&result)
+		meta := result["meta"].(map[string]interface{})
+		if meta["message"] != "acme" {
+			t.Errorf("Expected tenant 'acme', got %v", meta["message"])
+		}
+	})
+
+	t.Run("header_extraction", func(t *testing.T) {
+		app := setupTenantApp(config)
+
+		req := httptest.NewRequest("GET", "http://api.example.com/orders", nil)
+		req.Header.Set("X-Tenant-ID", "globex")
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var result map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&result)
+		meta := result["meta"].(map[string]interface{})
+		if meta["message"] != "globex" {
+			t.Errorf("Expected tenant 'globex', got %v", meta["message"])
+		}
+	})
+
+	t.Run("unknown_tenant", func(t *testing.T) {
+		app := setupTenantApp(config)
+
+		req := httptest.NewRequest("GET", "http://initech.api.example.com/orders", nil)
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if resp.StatusCode != 404 {
+			t.Errorf("Expected status 404, got %d", resp.StatusCode)
+		}
+
+		var result map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&result)
+		meta := result["meta"].(map[string]interface{})
+		if meta["message"] != "Unknown tenant: initech" {
+			t.Errorf("Expected unknown tenant message, got %v", meta["message"])
+		}
+	})
+
+	t.Run("missing_tenant", func(t *testing.T) {
+		app := setupTenantApp(config)
+
+		req := httptest.NewRequest("GET", "http://api.example.com/orders", nil)
+		resp, _ := app.Test(req)
+
+		if resp.StatusCode != 400 {
+			t.Errorf("Expected status 400, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("resolver", func(t *testing.T) {
+		app := setupTenantApp(TenantConfig{
+			Resolver: func(tenantID string) bool { return tenantID == "acme" },
+		})
+
+		req := httptest.NewRequest("GET", "/orders", nil)
+		req.Header.Set("X-Tenant-ID", "acme")
+		resp, _ := app.Test(req)
+		if resp.StatusCode != 200 {
+			t.Errorf("Expected status 200, got %d", resp.StatusCode)
+		}
+
+		req = httptest.NewRequest("GET", "/orders", nil)
+		req.Header.Set("X-Tenant-ID", "globex")
+		resp, _ = app.Test(req)
+		if resp.StatusCode != 404 {
+			t.Errorf("Expected status 404, got %d", resp.StatusCode)
+		}
+	})
+}
@@ -1,20 +1,113 @@
 package storage
 
-import "io"
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrObjectNotFound is returned when the requested file does not exist in the storage system.
+// It wraps fs.ErrNotExist, so errors.Is(err, os.ErrNotExist) also matches.
+var ErrObjectNotFound = fmt.Errorf("object not found: %w", fs.ErrNotExist)
+
+// FileInfo describes a stored object returned by List.
+type FileInfo struct {
+	// Key is the object path relative to the storage root, using "/" separators
+	Key string
+
+	// Size is the object size in bytes
+	Size int64
+
+	// LastModified is the time the object was last modified
+	LastModified time.Time
+}
+
+// SaveOptions holds optional settings for SaveWithOptions.
+type SaveOptions struct {
+	// ContentType forces the MIME type of the stored file.
+	// When empty, it is detected from the file extension or content.
+	ContentType string
+
+	// VerifyChecksum sends a SHA256 checksum of the source file with the upload,
+	// so the storage rejects a corrupted body. Only supported by S3Storage.
+	VerifyChecksum bool
+}
+
+// DeleteError is returned by DeleteMany when some files could not be deleted.
+// Files not listed in Failed were deleted successfully.
+type DeleteError struct {
+	// Failed maps each path that could not be deleted to its error
+	Failed map[string]error
+}
+
+// Error lists the failed paths in sorted order.
+func (e *DeleteError) Error() string {
+	paths := make([]string, 0, len(e.Failed))
+	for path := range e.Failed {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	msgs := make([]string, 0, len(paths))
+	for _, path := range paths {
+		msgs = append(msgs, fmt.Sprintf("%s: %v", path, e.Failed[path]))
+	}
+	return fmt.Sprintf("failed to delete %d file(s): %s", len(paths), strings.Join(msgs, "; "))
+}
 
 type BaseStorage interface {
 	// Save uploads a file from sourceFile path to the destination path in the storage system.
 	Save(sourceFile string, destination string) error
 
+	// SaveWithOptions uploads a file from sourceFile path to the destination path using the given options.
+	SaveWithOptions(sourceFile string, destination string, options SaveOptions) error
+
 	// SaveFromReader uploads a file from an io.Reader to the destination path in the storage system.
 	SaveFromReader(reader io.Reader, destination string) error
 
+	// SaveReader streams the content of reader to the destination path in the storage system.
+	// size is the content length in bytes; pass a negative value when the size is unknown.
+	SaveReader(reader io.Reader, destination string, size int64) error
+
+	// GetReader opens the file at the specified path for reading. The caller must close the reader.
+	// It returns an error wrapping ErrObjectNotFound when the file does not exist.
+	GetReader(path string) (io.ReadCloser, error)
+
 	// Delete removes the file at the specified path from the storage system.
 	Delete(path string) error
 
+	// DeleteMany removes all files at the specified paths from the storage system.
+	// It attempts every path and returns a *DeleteError listing the paths that failed.
+	DeleteMany(paths []string) error
+
+	// Copy duplicates the file at src to dst within the storage system.
+	Copy(src string, dst string) error
+
+	// Move relocates the file at src to dst within the storage system.
+	Move(src string, dst string) error
+
 	// Exists checks if a file exists at the specified path in the storage system.
 	Exists(path string) (bool, error)
 
+	// Size returns the size in bytes of the file at the specified path without reading its content.
+	// It returns an error wrapping ErrObjectNotFound when the file does not exist.
+	Size(path string) (int64, error)
+
+	// SetMetadata replaces the user metadata stored with the file at the specified path.
+	// It returns an error wrapping ErrObjectNotFound when the file does not exist.
+	SetMetadata(path string, meta map[string]string) error
+
+	// GetMetadata returns the user metadata stored with the file at the specified path.
+	// It returns an empty map when no metadata was set.
+	GetMetadata(path string) (map[string]string, error)
+
+	// List returns all files whose path starts with prefix.
+	// It returns an empty slice when nothing matches.
+	List(prefix string) ([]FileInfo, error)
+
 	// GetURL generates a publicly accessible URL for the file at the specified path.
 	GetURL(path string) (string, error)
 
@@ -0,0 +1,55 @@
+package storage
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+)
+
+// sniffLen is the number of bytes http.DetectContentType considers
+const sniffLen = 512
+
+// DetectContentType determines the MIME type of the content by sniffing its first
+// 512 bytes with http.DetectContentType, so the result cannot be spoofed by renaming a file.
+//
+// Reading the header consumes bytes from r, so the returned reader must be used in its place:
+// it replays the sniffed bytes followed by the rest of the stream (seekable readers are
+// rewound instead).
+//
+// Parameters:
+//   - r: io.Reader - The content to inspect
+//
+// Returns:
+//   - contentType: string - The detected MIME type, "application/octet-stream" if unknown
+//   - rewound: io.Reader - A reader yielding the full content
+//   - err: error - Error if reading the header fails
+//
+// Example:
+//
+//	contentType, reader, err := storage.DetectContentType(file)
+//	if err != nil {
+//	    return err
+//	}
+//	if contentType != "image/png" && contentType != "image/jpeg" {
+//	    return fiber.NewError(fiber.StatusUnsupportedMediaType, "Only PNG and JPEG images are allowed")
+//	}
+//	err = store.SaveFromReader(reader, "avatars/"+userID+".png")
+func DetectContentType(r io.Reader) (contentType string, rewound io.Reader, err error) {
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(r, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", nil, err
+	}
+	head = head[:n]
+	contentType = http.DetectContentType(head)
+
+	// Rewind seekable readers, otherwise replay the sniffed bytes
+	if seeker, ok := r.(io.Seeker); ok {
+		if _, err := seeker.Seek(-int64(n), io.SeekCurrent); err != nil {
+			return "", nil, err
+		}
+		return contentType, r, nil
+	}
+
+	return contentType, io.MultiReader(bytes.NewReader(head), r), nil
+}
@@ -0,0 +1,88 @@
+package storage
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+// onlyReader hides any Seek method so DetectContentType must replay the sniffed bytes
+type onlyReader struct {
+	io.Reader
+}
+
+func TestDetectContentType(t *testing.T) {
+	png := append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 1024)...)
+	pdf := []byte("%PDF-1.7\n" + strings.Repeat("1 0 obj << >> endobj\n", 50))
+	text := []byte("hello world, this is a plain text upload")
+
+	tests := []struct {
+		name     string
+		content  []byte
+		expected string
+	}{
+		{"png", png, "image/png"},
+		{"pdf", pdf, "application/pdf"},
+		{"text", text, "text/plain; charset=utf-8"},
+		{"empty", []byte{}, "text/plain; charset=utf-8"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			readers := map[string]io.Reader{
+				"stream":   onlyReader{bytes.NewReader(tt.content)},
+				"seekable": bytes.NewReader(tt.content),
+			}
+			for kind, r := range readers {
+				contentType, rewound, err := DetectContentType(r)
+				if err != nil {
+					t.Fatalf("%s: unexpected error: %v", kind, err)
+				}
+				if contentType != tt.expected {
+					t.Errorf("%s: expected '%s', got '%s'", kind, tt.expected, contentType)
+				}
+
+				data, _ := io.ReadAll(rewound)
+				if !bytes.Equal(data, tt.content) {
+					t.Errorf("%s: expected full stream of %d bytes, got %d bytes", kind, len(tt.content), len(data))
+				}
+			}
+		})
+	}
+}
+
+func TestDetectContentType_Extension(t *testing.T) {
+	png := append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 1024)...)
+	pdf := []byte("%PDF-1.7\n" + strings.Repeat("1 0 obj << >> endobj\n", 50))
+
+	tests := []struct {
+		name     string
+		file     string
+		content  []byte
+		expected string
+	}{
+		{"png_named_png", "avatar.png", png, "image/png"},
+		{"pdf_named_png", "avatar.png", pdf, "application/pdf"},
+		{"json_text", "data.json", []byte(`{"name": "budi"}`), "application/json"},
+		{"unknown_extension", "notes.unknownext", []byte("plain notes"), "text/plain; charset=utf-8"},
+		{"binary_no_extension", "blob", []byte{0x00, 0x01, 0x02, 0x03}, "application/octet-stream"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contentType, rewound, err := detectContentType(tt.file, bytes.NewReader(tt.content))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if contentType != tt.expected {
+				t.Errorf("expected '%s', got '%s'", tt.expected, contentType)
+			}
+
+			data, _ := io.ReadAll(rewound)
+			if !bytes.Equal(data, tt.content) {
+				t.Errorf("expected full stream of %d bytes, got %d bytes", len(tt.content), len(data))
+			}
+		})
+	}
+}
@@ -1,13 +1,18 @@
 package storage
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
 )
 
+// metadataSuffix is appended to a file path to name its metadata sidecar file
+const metadataSuffix = ".meta.json"
+
 type LocalStorage struct {
 	UploadDir string
 	BaseURL   string
@@ -52,6 +57,11 @@ func (ls *LocalStorage) Save(sourceFile string, destination string) error {
 	return nil
 }
 
+func (ls *LocalStorage) SaveWithOptions(sourceFile string, destination string, options SaveOptions) error {
+	// The local filesystem does not store a content type
+	return ls.Save(sourceFile, destination)
+}
+
 func (ls *LocalStorage) SaveFromReader(reader io.Reader, destination string) error {
 	// Construct the full destination path
 	destPath := filepath.Join(ls.UploadDir, destination)
@@ -77,6 +87,27 @@ func (ls *LocalStorage) SaveFromReader(reader io.Reader, destination string) err
 	return nil
 }
 
+func (ls *LocalStorage) SaveReader(reader io.Reader, destination string, size int64) error {
+	// The local filesystem does not need the size up front
+	return ls.SaveFromReader(reader, destination)
+}
+
+func (ls *LocalStorage) GetReader(path string) (io.ReadCloser, error) {
+	// Construct the full file path
+	filePath := filepath.Join(ls.UploadDir, path)
+
+	// Open the file
+	file, err := os.Open(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w: %s", ErrObjectNotFound, path)
+		}
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+
+	return file, nil
+}
+
 func (ls *LocalStorage) Delete(path string) error {
 	// Construct the full file path
 	filePath := filepath.Join(ls.UploadDir, path)
@@ -89,6 +120,111 @@ func (ls *LocalStorage) Delete(path string) error {
 		return fmt.Errorf("failed to delete file: %w", err)
 	}
 
+	// Delete the metadata sidecar, if any
+	if err := os.Remove(ls.metadataPath(path)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete file metadata: %w", err)
+	}
+
+	return nil
+}
+
+func (ls *LocalStorage) DeleteMany(paths []string) error {
+	failed := make(map[string]error)
+	for _, path := range paths {
+		if err := ls.Delete(path); err != nil {
+			failed[path] = err
+		}
+	}
+
+	if len(failed) > 0 {
+		return &DeleteError{Failed: failed}
+	}
+	return nil
+}
+
+func (ls *LocalStorage) Copy(src string, dst string) error {
+	// Construct the full file paths
+	srcPath := filepath.Join(ls.UploadDir, src)
+	dstPath := filepath.Join(ls.UploadDir, dst)
+
+	// Open the source file
+	srcFile, err := os.Open(srcPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("source file not found: %w: %s", ErrObjectNotFound, src)
+		}
+		return fmt.Errorf("failed to open source file: %w", err)
+	}
+	defer srcFile.Close()
+
+	// Copying a file onto itself is a no-op; os.Create would truncate it first
+	if same, err := sameFile(srcFile, dstPath); err != nil {
+		return err
+	} else if same {
+		return nil
+	}
+
+	// Create the directory if it doesn't exist
+	if err := os.MkdirAll(filepath.Dir(dstPath), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	// Create the destination file
+	dstFile, err := os.Create(dstPath)
+	if err != nil {
+		return fmt.Errorf("failed to create destination file: %w", err)
+	}
+	defer dstFile.Close()
+
+	// Copy the file content
+	if _, err := io.Copy(dstFile, srcFile); err != nil {
+		return fmt.Errorf("failed to copy file: %w", err)
+	}
+
+	return ls.copyMetadata(src, dst)
+}
+
+func (ls *LocalStorage) Move(src string, dst string) error {
+	// Construct the full file paths
+	srcPath := filepath.Join(ls.UploadDir, src)
+	dstPath := filepath.Join(ls.UploadDir, dst)
+
+	// Check the source file exists
+	srcInfo, err := os.Stat(srcPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("source file not found: %w: %s", ErrObjectNotFound, src)
+		}
+		return fmt.Errorf("failed to check source file: %w", err)
+	}
+
+	// Moving a file onto itself is a no-op; removing the "source" metadata would lose it
+	if dstInfo, err := os.Stat(dstPath); err == nil && os.SameFile(srcInfo, dstInfo) {
+		return nil
+	}
+
+	// Create the directory if it doesn't exist
+	if err := os.MkdirAll(filepath.Dir(dstPath), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	// Rename the file, falling back to copy and delete (e.g., across devices)
+	if err := os.Rename(srcPath, dstPath); err != nil {
+		if err := ls.Copy(src, dst); err != nil {
+			return err
+		}
+		if err := os.Remove(srcPath); err != nil {
+			return fmt.Errorf("failed to remove source file: %w", err)
+		}
+	} else if err := ls.copyMetadata(src, dst); err != nil {
+		return err
+	}
+
+	// Remove the source metadata sidecar, if any
+	if err := os.Remove(ls.metadataPath(src)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove source file metadata: %w", err)
+	}
+
 	return nil
 }
 
@@ -108,6 +244,163 @@ func (ls *LocalStorage) Exists(path string) (bool, error) {
 	return true, nil
 }
 
+func (ls *LocalStorage) Size(path string) (int64, error) {
+	// Construct the full file path
+	filePath := filepath.Join(ls.UploadDir, path)
+
+	info, err := os.Stat(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, fmt.Errorf("%w: %s", ErrObjectNotFound, path)
+		}
+		return 0, fmt.Errorf("failed to get file size: %w", err)
+	}
+	// Directories are not objects; other backends report them as missing too
+	if info.IsDir() {
+		return 0, fmt.Errorf("%w: %s is a directory", ErrObjectNotFound, path)
+	}
+
+	return info.Size(), nil
+}
+
+func (ls *LocalStorage) SetMetadata(path string, meta map[string]string) error {
+	// Make sure the file exists so metadata is never orphaned
+	exists, err := ls.Exists(path)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("%w: %s", ErrObjectNotFound, path)
+	}
+
+	// Persist the metadata in a sidecar file next to the object
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return fmt.Errorf("failed to encode file metadata: %w", err)
+	}
+	if err := os.WriteFile(ls.metadataPath(path), data, 0644); err != nil {
+		return fmt.Errorf("failed to write file metadata: %w", err)
+	}
+
+	return nil
+}
+
+func (ls *LocalStorage) GetMetadata(path string) (map[string]string, error) {
+	exists, err := ls.Exists(path)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", ErrObjectNotFound, path)
+	}
+
+	// Read the sidecar file; a missing sidecar means no metadata was set
+	meta := map[string]string{}
+	data, err := os.ReadFile(ls.metadataPath(path))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return meta, nil
+		}
+		return nil, fmt.Errorf("failed to read file metadata: %w", err)
+	}
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return nil, fmt.Errorf("failed to decode file metadata: %w", err)
+	}
+
+	return meta, nil
+}
+
+// metadataPath returns the full path of the metadata sidecar file for path.
+func (ls *LocalStorage) metadataPath(path string) string {
+	return filepath.Join(ls.UploadDir, path) + metadataSuffix
+}
+
+// sameFile reports whether dstPath refers to the same file as the open srcFile,
+// including through a different but equivalent path or a link.
+func sameFile(srcFile *os.File, dstPath string) (bool, error) {
+	dstInfo, err := os.Stat(dstPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check destination file: %w", err)
+	}
+	srcInfo, err := srcFile.Stat()
+	if err != nil {
+		return false, fmt.Errorf("failed to check source file: %w", err)
+	}
+	return os.SameFile(srcInfo, dstInfo), nil
+}
+
+// copyMetadata copies the metadata sidecar of src to dst,
+// removing any stale sidecar at dst when src has none.
+func (ls *LocalStorage) copyMetadata(src string, dst string) error {
+	data, err := os.ReadFile(ls.metadataPath(src))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return fmt.Errorf("failed to read file metadata: %w", err)
+		}
+		if err := os.Remove(ls.metadataPath(dst)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove stale file metadata: %w", err)
+		}
+		return nil
+	}
+
+	if err := os.WriteFile(ls.metadataPath(dst), data, 0644); err != nil {
+		return fmt.Errorf("failed to write file metadata: %w", err)
+	}
+	return nil
+}
+
+func (ls *LocalStorage) List(prefix string) ([]FileInfo, error) {
+	// Normalize the prefix, keeping a trailing slash meaningful
+	prefix = strings.TrimPrefix(filepath.ToSlash(prefix), "/")
+
+	// Only walk the directory that can contain matches
+	walkRoot := ls.UploadDir
+	if idx := strings.LastIndex(prefix, "/"); idx >= 0 {
+		walkRoot = filepath.Join(ls.UploadDir, filepath.FromSlash(prefix[:idx]))
+	}
+
+	files := []FileInfo{}
+	err := filepath.WalkDir(walkRoot, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(ls.UploadDir, path)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) || strings.HasSuffix(key, metadataSuffix) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		files = append(files, FileInfo{
+			Key:          key,
+			Size:         info.Size(),
+			LastModified: info.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files: %w", err)
+	}
+
+	return files, nil
+}
+
 func (ls *LocalStorage) GetURL(path string) (string, error) {
 	// Clean the path and replace backslashes with forward slashes for URLs
 	cleanPath := filepath.ToSlash(filepath.Clean(path))
@@ -0,0 +1,250 @@
+package storage
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// newTestLocalStorage creates a LocalStorage rooted in a temporary directory
+func newTestLocalStorage(t *testing.T) *LocalStorage {
+	return NewLocalStorage(t.TempDir(), "http://localhost/uploads").(*LocalStorage)
+}
+
+// readLocal returns the content of path in the storage, failing the test on error
+func readLocal(t *testing.T, ls *LocalStorage, path string) string {
+	data, err := os.ReadFile(filepath.Join(ls.UploadDir, path))
+	if err != nil {
+		t.Fatalf("Failed to read %s: %v", path, err)
+	}
+	return string(data)
+}
+
+func TestLocalStorage_Copy(t *testing.T) {
+	t.Run("copies_content_and_metadata", func(t *testing.T) {
+		ls := newTestLocalStorage(t)
+		ls.SaveFromReader(strings.NewReader("hello"), "docs/a.txt")
+		if err := ls.SetMetadata("docs/a.txt", map[string]string{"owner": "alice"}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := ls.Copy("docs/a.txt", "backup/a.txt"); err != nil {
+			t.Fatalf("Copy failed: %v", err)
+		}
+		if got := readLocal(t, ls, "docs/a.txt"); got != "hello" {
+			t.Errorf("Expected source to be kept, got %q", got)
+		}
+		if got := readLocal(t, ls, "backup/a.txt"); got != "hello" {
+			t.Errorf("Expected copied content, got %q", got)
+		}
+		meta, err := ls.GetMetadata("backup/a.txt")
+		if err != nil || meta["owner"] != "alice" {
+			t.Errorf("Expected metadata to be copied, got %v, %v", meta, err)
+		}
+	})
+
+	t.Run("same_path_keeps_content", func(t *testing.T) {
+		ls := newTestLocalStorage(t)
+		ls.SaveFromReader(strings.NewReader("hello"), "a.txt")
+
+		for _, dst := range []string{"a.txt", "./a.txt", "docs/../a.txt"} {
+			if err := ls.Copy("a.txt", dst); err != nil {
+				t.Fatalf("Copy to %q failed: %v", dst, err)
+			}
+			if got := readLocal(t, ls, "a.txt"); got != "hello" {
+				t.Fatalf("Expected content to survive Copy to %q, got %q", dst, got)
+			}
+		}
+	})
+
+	t.Run("missing_source", func(t *testing.T) {
+		ls := newTestLocalStorage(t)
+		if err := ls.Copy("missing.txt", "b.txt"); !errors.Is(err, ErrObjectNotFound) {
+			t.Errorf("Expected ErrObjectNotFound, got %v", err)
+		}
+		if exists, _ := ls.Exists("b.txt"); exists {
+			t.Error("Expected no destination file for a missing source")
+		}
+	})
+}
+
+func TestLocalStorage_Move(t *testing.T) {
+	t.Run("moves_content_and_metadata", func(t *testing.T) {
+		ls := newTestLocalStorage(t)
+		ls.SaveFromReader(strings.NewReader("hello"), "a.txt")
+		ls.SetMetadata("a.txt", map[string]string{"owner": "alice"})
+
+		if err := ls.Move("a.txt", "archive/a.txt"); err != nil {
+			t.Fatalf("Move failed: %v", err)
+		}
+		if exists, _ := ls.Exists("a.txt"); exists {
+			t.Error("Expected source to be removed")
+		}
+		if _, err := os.Stat(ls.metadataPath("a.txt")); !os.IsNotExist(err) {
+			t.Error("Expected source metadata sidecar to be removed")
+		}
+		if got := readLocal(t, ls, "archive/a.txt"); got != "hello" {
+			t.Errorf("Expected moved content, got %q", got)
+		}
+		meta, err := ls.GetMetadata("archive/a.txt")
+		if err != nil || meta["owner"] != "alice" {
+			t.Errorf("Expected metadata to move, got %v, %v", meta, err)
+		}
+	})
+
+	t.Run("same_path_keeps_content_and_metadata", func(t *testing.T) {
+		ls := newTestLocalStorage(t)
+		ls.SaveFromReader(strings.NewReader("hello"), "a.txt")
+		ls.SetMetadata("a.txt", map[string]string{"owner": "alice"})
+
+		if err := ls.Move("a.txt", "./a.txt"); err != nil {
+			t.Fatalf("Move failed: %v", err)
+		}
+		if got := readLocal(t, ls, "a.txt"); got != "hello" {
+			t.Errorf("Expected content to survive, got %q", got)
+		}
+		meta, err := ls.GetMetadata("a.txt")
+		if err != nil || meta["owner"] != "alice" {
+			t.Errorf("Expected metadata to survive, got %v, %v", meta, err)
+		}
+	})
+
+	t.Run("missing_source", func(t *testing.T) {
+		ls := newTestLocalStorage(t)
+		if err := ls.Move("missing.txt", "b.txt"); !errors.Is(err, ErrObjectNotFound) {
+			t.Errorf("Expected ErrObjectNotFound, got %v", err)
+		}
+	})
+}
+
+func TestLocalStorage(t *testing.T) {
+	t.Run("save_and_read", func(t *testing.T) {
+		ls := newTestLocalStorage(t)
+		if err := ls.SaveFromReader(strings.NewReader("hello"), "docs/a.txt"); err != nil {
+			t.Fatal(err)
+		}
+
+		exists, err := ls.Exists("docs/a.txt")
+		if err != nil || !exists {
+			t.Fatalf("Expected file to exist, got %v, %v", exists, err)
+		}
+		reader, err := ls.GetReader("docs/a.txt")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer reader.Close()
+		data, _ := io.ReadAll(reader)
+		if string(data) != "hello" {
+			t.Errorf("Expected 'hello', got %q", data)
+		}
+
+		if _, err := ls.GetReader("missing.txt"); !errors.Is(err, ErrObjectNotFound) {
+			t.Errorf("Expected ErrObjectNotFound, got %v", err)
+		}
+	})
+
+	t.Run("delete_removes_metadata", func(t *testing.T) {
+		ls := newTestLocalStorage(t)
+		ls.SaveFromReader(strings.NewReader("hello"), "a.txt")
+		ls.SetMetadata("a.txt", map[string]string{"owner": "alice"})
+
+		if err := ls.Delete("a.txt"); err != nil {
+			t.Fatal(err)
+		}
+		if exists, _ := ls.Exists("a.txt"); exists {
+			t.Error("Expected file to be deleted")
+		}
+		if _, err := os.Stat(ls.metadataPath("a.txt")); !os.IsNotExist(err) {
+			t.Errorf("Expected metadata sidecar to be deleted, got %v", err)
+		}
+	})
+
+	t.Run("list_hides_metadata", func(t *testing.T) {
+		ls := newTestLocalStorage(t)
+		ls.SaveFromReader(strings.NewReader("1"), "images/b.png")
+		ls.SaveFromReader(strings.NewReader("22"), "images/a.png")
+		ls.SaveFromReader(strings.NewReader("333"), "docs/c.txt")
+		ls.SetMetadata("images/a.png", map[string]string{"owner": "alice"})
+
+		files, err := ls.List("images/")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(files) != 2 || files[0].Key != "images/a.png" || files[1].Key != "images/b.png" {
+			t.Fatalf("Expected the two image files, got %+v", files)
+		}
+		if files[0].Size != 2 {
+			t.Errorf("Expected size 2, got %d", files[0].Size)
+		}
+	})
+}
+
+func TestLocalStorage_Size(t *testing.T) {
+	ls := newTestLocalStorage(t)
+	ls.SaveFromReader(strings.NewReader("hello"), "docs/a.txt")
+
+	size, err := ls.Size("docs/a.txt")
+	if err != nil || size != 5 {
+		t.Errorf("Expected size 5, got %d, %v", size, err)
+	}
+
+	for _, path := range []string{"docs", "missing.txt"} {
+		if _, err := ls.Size(path); !errors.Is(err, ErrObjectNotFound) {
+			t.Errorf("Expected ErrObjectNotFound for %q, got %v", path, err)
+		}
+	}
+}
+
+func TestLocalStorage_Metadata(t *testing.T) {
+	t.Run("set_and_get", func(t *testing.T) {
+		ls := newTestLocalStorage(t)
+		ls.SaveFromReader(strings.NewReader("hello"), "docs/a.txt")
+
+		meta, err := ls.GetMetadata("docs/a.txt")
+		if err != nil || len(meta) != 0 {
+			t.Errorf("Expected empty metadata before SetMetadata, got %v, %v", meta, err)
+		}
+
+		if err := ls.SetMetadata("docs/a.txt", map[string]string{"owner": "alice", "stage": "draft"}); err != nil {
+			t.Fatalf("SetMetadata failed: %v", err)
+		}
+		if err := ls.SetMetadata("docs/a.txt", map[string]string{"owner": "bob"}); err != nil {
+			t.Fatalf("SetMetadata failed: %v", err)
+		}
+
+		meta, err = ls.GetMetadata("docs/a.txt")
+		if err != nil || len(meta) != 1 || meta["owner"] != "bob" {
+			t.Errorf("Expected metadata to be replaced, got %v, %v", meta, err)
+		}
+		if got := readLocal(t, ls, "docs/a.txt"); got != "hello" {
+			t.Errorf("Expected content to be untouched, got %q", got)
+		}
+	})
+
+	t.Run("stored_in_sidecar", func(t *testing.T) {
+		ls := newTestLocalStorage(t)
+		ls.SaveFromReader(strings.NewReader("hello"), "a.txt")
+		ls.SetMetadata("a.txt", map[string]string{"owner": "alice"})
+
+		if got := readLocal(t, ls, "a.txt"+metadataSuffix); got != `{"owner":"alice"}` {
+			t.Errorf("Expected JSON sidecar, got %q", got)
+		}
+	})
+
+	t.Run("missing_file", func(t *testing.T) {
+		ls := newTestLocalStorage(t)
+
+		if err := ls.SetMetadata("missing.txt", map[string]string{"a": "b"}); !errors.Is(err, ErrObjectNotFound) {
+			t.Errorf("Expected ErrObjectNotFound from SetMetadata, got %v", err)
+		}
+		if _, err := os.Stat(filepath.Join(ls.UploadDir, "missing.txt"+metadataSuffix)); !os.IsNotExist(err) {
+			t.Errorf("Expected no orphaned sidecar, got %v", err)
+		}
+		if _, err := ls.GetMetadata("missing.txt"); !errors.Is(err, ErrObjectNotFound) {
+			t.Errorf("Expected ErrObjectNotFound from GetMetadata, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,246 @@
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// memoryObject is a file held by MemoryStorage
+type memoryObject struct {
+	data         []byte
+	metadata     map[string]string
+	lastModified time.Time
+}
+
+// MemoryStorage is an in-memory BaseStorage implementation intended for tests.
+// Files are kept in a map guarded by a mutex, so it is safe for concurrent use.
+type MemoryStorage struct {
+	mu    sync.RWMutex
+	files map[string]*memoryObject
+}
+
+// NewMemoryStorage creates an empty in-memory storage.
+// URLs have the form "mem://<key>"; signed URLs append "?expires=<seconds>".
+func NewMemoryStorage() BaseStorage {
+	return &MemoryStorage{
+		files: make(map[string]*memoryObject),
+	}
+}
+
+// memoryKey cleans a path the same way the S3 backend does.
+func memoryKey(path string) string {
+	key := filepath.ToSlash(filepath.Clean(path))
+	return strings.TrimPrefix(key, "/")
+}
+
+func (ms *MemoryStorage) Save(sourceFile string, destination string) error {
+	// Open the source file
+	file, err := os.Open(sourceFile)
+	if err != nil {
+		return fmt.Errorf("failed to open source file: %w", err)
+	}
+	defer file.Close()
+
+	return ms.SaveFromReader(file, destination)
+}
+
+func (ms *MemoryStorage) SaveWithOptions(sourceFile string, destination string, options SaveOptions) error {
+	// Memory storage does not store a content type
+	return ms.Save(sourceFile, destination)
+}
+
+func (ms *MemoryStorage) SaveFromReader(reader io.Reader, destination string) error {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return fmt.Errorf("failed to copy file from reader: %w", err)
+	}
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	ms.files[memoryKey(destination)] = &memoryObject{
+		data:         data,
+		lastModified: time.Now(),
+	}
+
+	return nil
+}
+
+func (ms *MemoryStorage) SaveReader(reader io.Reader, destination string, size int64) error {
+	// Memory storage does not need the size up front
+	return ms.SaveFromReader(reader, destination)
+}
+
+func (ms *MemoryStorage) GetReader(path string) (io.ReadCloser, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	obj, ok := ms.files[memoryKey(path)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrObjectNotFound, path)
+	}
+
+	return io.NopCloser(bytes.NewReader(obj.data)), nil
+}
+
+func (ms *MemoryStorage) Delete(path string) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	key := memoryKey(path)
+	if _, ok := ms.files[key]; !ok {
+		return fmt.Errorf("%w: %s", ErrObjectNotFound, path)
+	}
+	delete(ms.files, key)
+
+	return nil
+}
+
+func (ms *MemoryStorage) DeleteMany(paths []string) error {
+	failed := make(map[string]error)
+	for _, path := range paths {
+		if err := ms.Delete(path); err != nil {
+			failed[path] = err
+		}
+	}
+
+	if len(failed) > 0 {
+		return &DeleteError{Failed: failed}
+	}
+	return nil
+}
+
+func (ms *MemoryStorage) Copy(src string, dst string) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	obj, ok := ms.files[memoryKey(src)]
+	if !ok {
+		return fmt.Errorf("source file not found: %w: %s", ErrObjectNotFound, src)
+	}
+	ms.files[memoryKey(dst)] = &memoryObject{
+		data:         bytes.Clone(obj.data),
+		metadata:     copyMetadataMap(obj.metadata),
+		lastModified: time.Now(),
+	}
+
+	return nil
+}
+
+func (ms *MemoryStorage) Move(src string, dst string) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	srcKey := memoryKey(src)
+	obj, ok := ms.files[srcKey]
+	if !ok {
+		return fmt.Errorf("source file not found: %w: %s", ErrObjectNotFound, src)
+	}
+	delete(ms.files, srcKey)
+	ms.files[memoryKey(dst)] = obj
+
+	return nil
+}
+
+func (ms *MemoryStorage) Exists(path string) (bool, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	_, ok := ms.files[memoryKey(path)]
+	return ok, nil
+}
+
+func (ms *MemoryStorage) Size(path string) (int64, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	obj, ok := ms.files[memoryKey(path)]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrObjectNotFound, path)
+	}
+
+	return int64(len(obj.data)), nil
+}
+
+func (ms *MemoryStorage) SetMetadata(path string, meta map[string]string) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	obj, ok := ms.files[memoryKey(path)]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrObjectNotFound, path)
+	}
+	obj.metadata = copyMetadataMap(meta)
+
+	return nil
+}
+
+func (ms *MemoryStorage) GetMetadata(path string) (map[string]string, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	obj, ok := ms.files[memoryKey(path)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrObjectNotFound, path)
+	}
+
+	meta := copyMetadataMap(obj.metadata)
+	if meta == nil {
+		meta = map[string]string{}
+	}
+	return meta, nil
+}
+
+func (ms *MemoryStorage) List(prefix string) ([]FileInfo, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	prefix = strings.TrimPrefix(filepath.ToSlash(prefix), "/")
+
+	files := []FileInfo{}
+	for key, obj := range ms.files {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		files = append(files, FileInfo{
+			Key:          key,
+			Size:         int64(len(obj.data)),
+			LastModified: obj.lastModified,
+		})
+	}
+
+	// Sort by key so results are deterministic
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Key < files[j].Key
+	})
+
+	return files, nil
+}
+
+func (ms *MemoryStorage) GetURL(path string) (string, error) {
+	return "mem://" + memoryKey(path), nil
+}
+
+func (ms *MemoryStorage) GetSignedURL(path string, expirySeconds int64) (string, error) {
+	url, _ := ms.GetURL(path)
+	return url + "?expires=" + strconv.FormatInt(expirySeconds, 10), nil
+}
+
+// copyMetadataMap returns a copy of meta so callers cannot mutate stored metadata.
+func copyMetadataMap(meta map[string]string) map[string]string {
+	if meta == nil {
+		return nil
+	}
+	copied := make(map[string]string, len(meta))
+	for k, v := range meta {
+		copied[k] = v
+	}
+	return copied
+}
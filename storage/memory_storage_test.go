@@ -0,0 +1,153 @@
+package storage
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestMemoryStorage(t *testing.T) {
+	t.Run("save_and_read", func(t *testing.T) {
+		store := NewMemoryStorage()
+
+		if err := store.SaveFromReader(strings.NewReader("hello"), "/docs/a.txt"); err != nil {
+			t.Fatal(err)
+		}
+
+		exists, _ := store.Exists("docs/a.txt")
+		if !exists {
+			t.Fatal("Expected file to exist")
+		}
+
+		reader, err := store.GetReader("docs/a.txt")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer reader.Close()
+		data, _ := io.ReadAll(reader)
+		if string(data) != "hello" {
+			t.Errorf("Expected 'hello', got '%s'", data)
+		}
+	})
+
+	t.Run("size", func(t *testing.T) {
+		store := NewMemoryStorage()
+		store.SaveFromReader(strings.NewReader("hello"), "a.txt")
+
+		size, err := store.Size("a.txt")
+		if err != nil || size != 5 {
+			t.Errorf("Expected size 5, got %d, %v", size, err)
+		}
+		if _, err := store.Size("missing.txt"); !errors.Is(err, ErrObjectNotFound) {
+			t.Errorf("Expected ErrObjectNotFound, got %v", err)
+		}
+	})
+
+	t.Run("missing_file", func(t *testing.T) {
+		store := NewMemoryStorage()
+
+		if _, err := store.GetReader("missing.txt"); !errors.Is(err, ErrObjectNotFound) {
+			t.Errorf("Expected ErrObjectNotFound, got %v", err)
+		}
+		if err := store.Delete("missing.txt"); !errors.Is(err, ErrObjectNotFound) {
+			t.Errorf("Expected ErrObjectNotFound on delete, got %v", err)
+		}
+		exists, err := store.Exists("missing.txt")
+		if err != nil || exists {
+			t.Errorf("Expected missing file to not exist, got %v, %v", exists, err)
+		}
+	})
+
+	t.Run("copy_move_delete", func(t *testing.T) {
+		store := NewMemoryStorage()
+		store.SaveFromReader(strings.NewReader("data"), "a.txt")
+		store.SetMetadata("a.txt", map[string]string{"owner": "42"})
+
+		if err := store.Copy("a.txt", "b.txt"); err != nil {
+			t.Fatal(err)
+		}
+		if err := store.Move("b.txt", "c.txt"); err != nil {
+			t.Fatal(err)
+		}
+		if exists, _ := store.Exists("b.txt"); exists {
+			t.Error("Expected moved source to be gone")
+		}
+		meta, err := store.GetMetadata("c.txt")
+		if err != nil || meta["owner"] != "42" {
+			t.Errorf("Expected metadata to follow the file, got %v, %v", meta, err)
+		}
+
+		err = store.DeleteMany([]string{"a.txt", "c.txt", "missing.txt"})
+		var delErr *DeleteError
+		if !errors.As(err, &delErr) || len(delErr.Failed) != 1 {
+			t.Fatalf("Expected DeleteError for one path, got %v", err)
+		}
+		if _, ok := delErr.Failed["missing.txt"]; !ok {
+			t.Errorf("Expected missing.txt to fail, got %v", delErr.Failed)
+		}
+	})
+
+	t.Run("metadata", func(t *testing.T) {
+		store := NewMemoryStorage()
+		store.SaveFromReader(strings.NewReader("data"), "a.txt")
+
+		meta, err := store.GetMetadata("a.txt")
+		if err != nil || meta == nil || len(meta) != 0 {
+			t.Errorf("Expected empty metadata before SetMetadata, got %v, %v", meta, err)
+		}
+
+		input := map[string]string{"owner": "alice", "stage": "draft"}
+		store.SetMetadata("a.txt", input)
+		store.SetMetadata("a.txt", map[string]string{"owner": "bob"})
+		input["owner"] = "mallory"
+
+		meta, err = store.GetMetadata("a.txt")
+		if err != nil || len(meta) != 1 || meta["owner"] != "bob" {
+			t.Errorf("Expected metadata to be replaced, got %v, %v", meta, err)
+		}
+		meta["owner"] = "mallory"
+		if meta, _ := store.GetMetadata("a.txt"); meta["owner"] != "bob" {
+			t.Errorf("Expected stored metadata to be isolated from callers, got %v", meta)
+		}
+
+		if err := store.SetMetadata("missing.txt", input); !errors.Is(err, ErrObjectNotFound) {
+			t.Errorf("Expected ErrObjectNotFound from SetMetadata, got %v", err)
+		}
+		if _, err := store.GetMetadata("missing.txt"); !errors.Is(err, ErrObjectNotFound) {
+			t.Errorf("Expected ErrObjectNotFound from GetMetadata, got %v", err)
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		store := NewMemoryStorage()
+		store.SaveFromReader(strings.NewReader("1"), "images/b.png")
+		store.SaveFromReader(strings.NewReader("22"), "images/a.png")
+		store.SaveFromReader(strings.NewReader("333"), "docs/c.txt")
+
+		files, err := store.List("images/")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(files) != 2 || files[0].Key != "images/a.png" || files[1].Key != "images/b.png" {
+			t.Fatalf("Expected sorted image files, got %+v", files)
+		}
+		if files[0].Size != 2 {
+			t.Errorf("Expected size 2, got %d", files[0].Size)
+		}
+	})
+
+	t.Run("urls", func(t *testing.T) {
+		store := NewMemoryStorage()
+
+		url, _ := store.GetURL("/images/photo.png")
+		if url != "mem://images/photo.png" {
+			t.Errorf("Expected 'mem://images/photo.png', got '%s'", url)
+		}
+
+		signed, _ := store.GetSignedURL("images/photo.png", 60)
+		if signed != "mem://images/photo.png?expires=60" {
+			t.Errorf("Expected 'mem://images/photo.png?expires=60', got '%s'", signed)
+		}
+	})
+}
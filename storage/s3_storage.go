@@ -2,9 +2,12 @@ package storage
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
+	"mime"
 	"net/url"
 	"os"
 	"path/filepath"
@@ -12,14 +15,19 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
 )
 
+// maxDeleteObjects is the maximum number of keys S3 accepts in a single DeleteObjects request
+const maxDeleteObjects = 1000
+
 type S3Config struct {
 	Region          string
 	Bucket          string
@@ -28,6 +36,10 @@ type S3Config struct {
 	EndpointURL     string
 	PublicURL       string
 	PrivateURL      string
+
+	// CDNBaseURL, when set, is used by GetURL instead of PublicURL so public assets are served
+	// through a CDN. Signed URLs always point at the origin.
+	CDNBaseURL string
 }
 
 type S3Storage struct {
@@ -75,6 +87,10 @@ func NewS3Storage(s3Config S3Config) BaseStorage {
 }
 
 func (s3s *S3Storage) Save(sourceFile string, destination string) error {
+	return s3s.SaveWithOptions(sourceFile, destination, SaveOptions{})
+}
+
+func (s3s *S3Storage) SaveWithOptions(sourceFile string, destination string, options SaveOptions) error {
 	// Open the source file
 	file, err := os.Open(sourceFile)
 	if err != nil {
@@ -82,19 +98,99 @@ func (s3s *S3Storage) Save(sourceFile string, destination string) error {
 	}
 	defer file.Close()
 
-	return s3s.SaveFromReader(file, destination)
+	if options.VerifyChecksum {
+		_, err := s3s.uploadWithChecksum(file, destination, options.ContentType)
+		return err
+	}
+
+	return s3s.upload(file, destination, options.ContentType)
+}
+
+// SaveWithChecksum uploads sourceFile to destination with a SHA256 checksum, so S3 rejects
+// the upload if the received body does not match the file. It returns the base64-encoded
+// SHA256 checksum (the same value S3 reports as ChecksumSHA256) for storing alongside metadata.
+func (s3s *S3Storage) SaveWithChecksum(sourceFile string, destination string) (string, error) {
+	// Open the source file
+	file, err := os.Open(sourceFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to open source file: %w", err)
+	}
+	defer file.Close()
+
+	return s3s.uploadWithChecksum(file, destination, "")
+}
+
+// uploadWithChecksum hashes the file, rewinds it and uploads it with PutObject carrying
+// the SHA256 checksum. If contentType is empty, it is detected from the destination and the content.
+func (s3s *S3Storage) uploadWithChecksum(file *os.File, destination string, contentType string) (string, error) {
+	// Clean the destination path
+	key := filepath.ToSlash(filepath.Clean(destination))
+	key = strings.TrimPrefix(key, "/")
+
+	// Compute the checksum of the whole file
+	hash := sha256.New()
+	size, err := io.Copy(hash, file)
+	if err != nil {
+		return "", fmt.Errorf("failed to compute checksum: %w", err)
+	}
+	checksum := base64.StdEncoding.EncodeToString(hash.Sum(nil))
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", fmt.Errorf("failed to rewind source file: %w", err)
+	}
+
+	// Detect the content type so browsers render the object instead of downloading it
+	var reader io.Reader = file
+	if contentType == "" {
+		contentType, reader, err = detectContentType(key, reader)
+		if err != nil {
+			return "", fmt.Errorf("failed to detect content type: %w", err)
+		}
+	}
+
+	// Upload the file with the checksum; S3 rejects the body if it does not match
+	_, err = s3s.client.PutObject(context.TODO(), &s3.PutObjectInput{
+		Bucket:            aws.String(s3s.Config.Bucket),
+		Key:               aws.String(key),
+		Body:              reader,
+		ContentLength:     aws.Int64(size),
+		ContentType:       aws.String(contentType),
+		ChecksumAlgorithm: types.ChecksumAlgorithmSha256,
+		ChecksumSHA256:    aws.String(checksum),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to upload file to S3: %w", err)
+	}
+
+	return checksum, nil
 }
 
 func (s3s *S3Storage) SaveFromReader(reader io.Reader, destination string) error {
+	return s3s.upload(reader, destination, "")
+}
+
+// upload sends the reader to S3 through the multipart uploader.
+// If contentType is empty, it is detected from the destination and the content.
+func (s3s *S3Storage) upload(reader io.Reader, destination string, contentType string) error {
 	// Clean the destination path
 	key := filepath.ToSlash(filepath.Clean(destination))
 	key = strings.TrimPrefix(key, "/")
 
+	// Detect the content type so browsers render the object instead of downloading it
+	if contentType == "" {
+		var err error
+		contentType, reader, err = detectContentType(key, reader)
+		if err != nil {
+			return fmt.Errorf("failed to detect content type: %w", err)
+		}
+	}
+
 	// Upload the file to S3
 	_, err := s3s.uploader.Upload(context.TODO(), &s3.PutObjectInput{
-		Bucket: aws.String(s3s.Config.Bucket),
-		Key:    aws.String(key),
-		Body:   reader,
+		Bucket:      aws.String(s3s.Config.Bucket),
+		Key:         aws.String(key),
+		Body:        reader,
+		ContentType: aws.String(contentType),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to upload file to S3: %w", err)
@@ -103,6 +199,78 @@ func (s3s *S3Storage) SaveFromReader(reader io.Reader, destination string) error
 	return nil
 }
 
+func (s3s *S3Storage) SaveReader(reader io.Reader, destination string, size int64) error {
+	// Unknown size: let the multipart uploader split the stream into parts
+	if size < 0 {
+		return s3s.SaveFromReader(reader, destination)
+	}
+
+	// Clean the destination path
+	key := filepath.ToSlash(filepath.Clean(destination))
+	key = strings.TrimPrefix(key, "/")
+
+	// Detect the content type so browsers render the object instead of downloading it
+	contentType, reader, err := detectContentType(key, reader)
+	if err != nil {
+		return fmt.Errorf("failed to detect content type: %w", err)
+	}
+
+	// Stream the reader directly with PutObject. The payload is sent unsigned so that
+	// non-seekable readers (e.g., multipart form files) do not need to be buffered.
+	_, err = s3s.client.PutObject(context.TODO(), &s3.PutObjectInput{
+		Bucket:        aws.String(s3s.Config.Bucket),
+		Key:           aws.String(key),
+		Body:          reader,
+		ContentLength: aws.Int64(size),
+		ContentType:   aws.String(contentType),
+	}, s3.WithAPIOptions(v4.SwapComputePayloadSHA256ForUnsignedPayloadMiddleware))
+	if err != nil {
+		return fmt.Errorf("failed to upload file to S3: %w", err)
+	}
+
+	return nil
+}
+
+// detectContentType determines the MIME type of an upload by sniffing the content with
+// DetectContentType, so a misleading extension cannot override the real type. The file
+// extension is only used when sniffing gives a generic type (application/octet-stream or
+// text/plain) and the extension maps to a more specific one, e.g. .json or .css.
+// It returns a reader that still yields the full content.
+func detectContentType(name string, reader io.Reader) (string, io.Reader, error) {
+	contentType, reader, err := DetectContentType(reader)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if contentType == "application/octet-stream" || strings.HasPrefix(contentType, "text/plain") {
+		if byExt := mime.TypeByExtension(filepath.Ext(name)); byExt != "" {
+			contentType = byExt
+		}
+	}
+
+	return contentType, reader, nil
+}
+
+func (s3s *S3Storage) GetReader(path string) (io.ReadCloser, error) {
+	// Clean the path
+	key := filepath.ToSlash(filepath.Clean(path))
+	key = strings.TrimPrefix(key, "/")
+
+	// Get the object from S3
+	output, err := s3s.client.GetObject(context.TODO(), &s3.GetObjectInput{
+		Bucket: aws.String(s3s.Config.Bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if isS3NotFound(err) {
+			return nil, fmt.Errorf("%w: %s", ErrObjectNotFound, key)
+		}
+		return nil, fmt.Errorf("failed to get file from S3: %w", err)
+	}
+
+	return output.Body, nil
+}
+
 func (s3s *S3Storage) Delete(path string) error {
 	// Clean the path
 	key := filepath.ToSlash(filepath.Clean(path))
@@ -120,6 +288,99 @@ func (s3s *S3Storage) Delete(path string) error {
 	return nil
 }
 
+func (s3s *S3Storage) DeleteMany(paths []string) error {
+	failed := make(map[string]error)
+
+	// DeleteObjects accepts at most maxDeleteObjects keys per request
+	for start := 0; start < len(paths); start += maxDeleteObjects {
+		end := start + maxDeleteObjects
+		if end > len(paths) {
+			end = len(paths)
+		}
+		chunk := paths[start:end]
+
+		// Clean the paths, remembering the original path of each key
+		keyToPath := make(map[string]string, len(chunk))
+		objects := make([]types.ObjectIdentifier, 0, len(chunk))
+		for _, path := range chunk {
+			key := filepath.ToSlash(filepath.Clean(path))
+			key = strings.TrimPrefix(key, "/")
+			keyToPath[key] = path
+			objects = append(objects, types.ObjectIdentifier{Key: aws.String(key)})
+		}
+
+		output, err := s3s.client.DeleteObjects(context.TODO(), &s3.DeleteObjectsInput{
+			Bucket: aws.String(s3s.Config.Bucket),
+			Delete: &types.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			for _, path := range chunk {
+				failed[path] = fmt.Errorf("failed to delete file from S3: %w", err)
+			}
+			continue
+		}
+
+		for _, e := range output.Errors {
+			key := aws.ToString(e.Key)
+			path, ok := keyToPath[key]
+			if !ok {
+				path = key
+			}
+			failed[path] = fmt.Errorf("failed to delete file from S3: %s: %s", aws.ToString(e.Code), aws.ToString(e.Message))
+		}
+	}
+
+	if len(failed) > 0 {
+		return &DeleteError{Failed: failed}
+	}
+	return nil
+}
+
+func (s3s *S3Storage) Copy(src string, dst string) error {
+	// Clean the paths
+	srcKey := filepath.ToSlash(filepath.Clean(src))
+	srcKey = strings.TrimPrefix(srcKey, "/")
+	dstKey := filepath.ToSlash(filepath.Clean(dst))
+	dstKey = strings.TrimPrefix(dstKey, "/")
+
+	// Make sure the source exists so a missing key is reported consistently
+	exists, err := s3s.Exists(srcKey)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("source file not found: %w: %s", ErrObjectNotFound, srcKey)
+	}
+
+	// Copy the object within the bucket
+	_, err = s3s.client.CopyObject(context.TODO(), &s3.CopyObjectInput{
+		Bucket:     aws.String(s3s.Config.Bucket),
+		Key:        aws.String(dstKey),
+		CopySource: aws.String(s3s.copySource(srcKey)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to copy file in S3: %w", err)
+	}
+
+	return nil
+}
+
+func (s3s *S3Storage) Move(src string, dst string) error {
+	if err := s3s.Copy(src, dst); err != nil {
+		return err
+	}
+
+	// Delete the source after a successful copy
+	if err := s3s.Delete(src); err != nil {
+		return fmt.Errorf("failed to remove source file after copy: %w", err)
+	}
+
+	return nil
+}
+
 func (s3s *S3Storage) Exists(path string) (bool, error) {
 	// Clean the path
 	key := filepath.ToSlash(filepath.Clean(path))
@@ -131,24 +392,153 @@ func (s3s *S3Storage) Exists(path string) (bool, error) {
 		Key:    aws.String(key),
 	})
 	if err != nil {
-		// Check for NoSuchKey error
-		var nsk *types.NoSuchKey
-		if errors.As(err, &nsk) {
+		if isS3NotFound(err) {
 			return false, nil
 		}
-		// Check for NotFound error
-		var apiErr smithy.APIError
-		if errors.As(err, &apiErr) {
-			if apiErr.ErrorCode() == "NotFound" {
-				return false, nil
-			}
-		}
 		return false, fmt.Errorf("failed to check file existence in S3: %w", err)
 	}
 
 	return true, nil
 }
 
+func (s3s *S3Storage) Size(path string) (int64, error) {
+	// Clean the path
+	key := filepath.ToSlash(filepath.Clean(path))
+	key = strings.TrimPrefix(key, "/")
+
+	// Read the content length from the object headers
+	head, err := s3s.client.HeadObject(context.TODO(), &s3.HeadObjectInput{
+		Bucket: aws.String(s3s.Config.Bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if isS3NotFound(err) {
+			return 0, fmt.Errorf("%w: %s", ErrObjectNotFound, key)
+		}
+		return 0, fmt.Errorf("failed to get file size from S3: %w", err)
+	}
+
+	return aws.ToInt64(head.ContentLength), nil
+}
+
+// copySource returns the CopySource value for key. It must be URL-encoded, keeping the "/" separators.
+func (s3s *S3Storage) copySource(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return s3s.Config.Bucket + "/" + strings.Join(segments, "/")
+}
+
+func (s3s *S3Storage) SetMetadata(path string, meta map[string]string) error {
+	// Clean the path
+	key := filepath.ToSlash(filepath.Clean(path))
+	key = strings.TrimPrefix(key, "/")
+
+	// Read the current system metadata, since replacing metadata would otherwise reset it
+	head, err := s3s.client.HeadObject(context.TODO(), &s3.HeadObjectInput{
+		Bucket: aws.String(s3s.Config.Bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if isS3NotFound(err) {
+			return fmt.Errorf("%w: %s", ErrObjectNotFound, key)
+		}
+		return fmt.Errorf("failed to read file metadata from S3: %w", err)
+	}
+
+	// S3 metadata is immutable, so copy the object onto itself with the new user metadata.
+	// REPLACE drops every header that is not sent again, so carry over the existing ones.
+	_, err = s3s.client.CopyObject(context.TODO(), &s3.CopyObjectInput{
+		Bucket:             aws.String(s3s.Config.Bucket),
+		Key:                aws.String(key),
+		CopySource:         aws.String(s3s.copySource(key)),
+		ContentType:        head.ContentType,
+		CacheControl:       head.CacheControl,
+		ContentDisposition: head.ContentDisposition,
+		ContentEncoding:    head.ContentEncoding,
+		ContentLanguage:    head.ContentLanguage,
+		Expires:            head.Expires,
+		Metadata:           meta,
+		MetadataDirective:  types.MetadataDirectiveReplace,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set file metadata in S3: %w", err)
+	}
+
+	return nil
+}
+
+func (s3s *S3Storage) GetMetadata(path string) (map[string]string, error) {
+	// Clean the path
+	key := filepath.ToSlash(filepath.Clean(path))
+	key = strings.TrimPrefix(key, "/")
+
+	// Read the user metadata (x-amz-meta-*) from the object headers
+	head, err := s3s.client.HeadObject(context.TODO(), &s3.HeadObjectInput{
+		Bucket: aws.String(s3s.Config.Bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if isS3NotFound(err) {
+			return nil, fmt.Errorf("%w: %s", ErrObjectNotFound, key)
+		}
+		return nil, fmt.Errorf("failed to read file metadata from S3: %w", err)
+	}
+
+	meta := make(map[string]string, len(head.Metadata))
+	for k, v := range head.Metadata {
+		meta[k] = v
+	}
+	return meta, nil
+}
+
+// isS3NotFound reports whether err is a NoSuchKey or NotFound error from S3.
+func isS3NotFound(err error) bool {
+	// Check for NoSuchKey error
+	var nsk *types.NoSuchKey
+	if errors.As(err, &nsk) {
+		return true
+	}
+	// Check for NotFound error (HeadObject has no typed error)
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		if apiErr.ErrorCode() == "NotFound" || apiErr.ErrorCode() == "NoSuchKey" {
+			return true
+		}
+	}
+	return false
+}
+
+func (s3s *S3Storage) List(prefix string) ([]FileInfo, error) {
+	// Normalize the prefix, keeping a trailing slash meaningful
+	prefix = strings.TrimPrefix(filepath.ToSlash(prefix), "/")
+
+	// Page through all objects, ListObjectsV2 returns at most 1000 per call
+	paginator := s3.NewListObjectsV2Paginator(s3s.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s3s.Config.Bucket),
+		Prefix: aws.String(prefix),
+	})
+
+	files := []FileInfo{}
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			return nil, fmt.Errorf("failed to list files in S3: %w", err)
+		}
+
+		for _, obj := range page.Contents {
+			files = append(files, FileInfo{
+				Key:          aws.ToString(obj.Key),
+				Size:         aws.ToInt64(obj.Size),
+				LastModified: aws.ToTime(obj.LastModified),
+			})
+		}
+	}
+
+	return files, nil
+}
+
 func (s3s *S3Storage) GetURL(path string) (string, error) {
 	// Clean the path and replace backslashes with forward slashes for URLs
 	cleanPath := filepath.ToSlash(filepath.Clean(path))
@@ -156,8 +546,11 @@ func (s3s *S3Storage) GetURL(path string) (string, error) {
 	// Remove leading slash if exists to avoid double slashes in URL
 	cleanPath = strings.TrimPrefix(cleanPath, "/")
 
-	// Combine base URL with path
+	// Combine base URL with path, preferring the CDN host when configured
 	urlStr := s3s.Config.PublicURL
+	if s3s.Config.CDNBaseURL != "" {
+		urlStr = s3s.Config.CDNBaseURL
+	}
 	if !strings.HasSuffix(urlStr, "/") && cleanPath != "" {
 		urlStr += "/"
 	}
@@ -180,9 +573,48 @@ func (s3s *S3Storage) GetSignedURL(path string, expirySeconds int64) (string, er
 		return "", fmt.Errorf("failed to generate signed URL: %w", err)
 	}
 
+	return s3s.rewriteSignedURL(key, presignedURL.URL)
+}
+
+// GetSignedUploadURL generates a presigned PUT URL so clients can upload directly to S3.
+// The Content-Type is part of the signature, so the client's PUT request must send the
+// same Content-Type header. The key is cleaned the same way as Save.
+func (s3s *S3Storage) GetSignedUploadURL(path string, expirySeconds int64, contentType string) (string, error) {
+	// Clean the path
+	key := filepath.ToSlash(filepath.Clean(path))
+	key = strings.TrimPrefix(key, "/")
+
+	optFns := []func(*s3.PresignOptions){
+		s3.WithPresignExpires((time.Duration(expirySeconds) * time.Second)),
+	}
+	if contentType != "" {
+		// PresignPutObject strips Content-Type before signing, so set it back as a
+		// build step header to make it part of the signed headers.
+		optFns = append(optFns, func(o *s3.PresignOptions) {
+			o.ClientOptions = append(o.ClientOptions, func(opts *s3.Options) {
+				opts.APIOptions = append(opts.APIOptions, smithyhttp.SetHeaderValue("Content-Type", contentType))
+			})
+		})
+	}
+
+	// Generate the presigned URL
+	presignedURL, err := s3s.presignClient.PresignPutObject(context.TODO(), &s3.PutObjectInput{
+		Bucket: aws.String(s3s.Config.Bucket),
+		Key:    aws.String(key),
+	}, optFns...)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate signed upload URL: %w", err)
+	}
+
+	return s3s.rewriteSignedURL(key, presignedURL.URL)
+}
+
+// rewriteSignedURL moves a presigned URL onto the PublicURL host when PrivateURL is configured,
+// keeping the signature query parameters intact.
+func (s3s *S3Storage) rewriteSignedURL(key, presignedURL string) (string, error) {
 	// If PublicURL is provided, replace with custom domain and path
 	if s3s.Config.PrivateURL != "" {
-		parsedPresigned, err := url.Parse(presignedURL.URL)
+		parsedPresigned, err := url.Parse(presignedURL)
 		if err != nil {
 			return "", fmt.Errorf("failed to parse presigned URL: %w", err)
 		}
@@ -218,5 +650,61 @@ func (s3s *S3Storage) GetSignedURL(path string, expirySeconds int64) (string, er
 		return finalURL.String(), nil
 	}
 
-	return presignedURL.URL, nil
+	return presignedURL, nil
+}
+
+// multipartAPI is the subset of the S3 client used to clean up multipart uploads,
+// so the cleanup logic can be tested without a live bucket.
+type multipartAPI interface {
+	ListMultipartUploads(ctx context.Context, params *s3.ListMultipartUploadsInput, optFns ...func(*s3.Options)) (*s3.ListMultipartUploadsOutput, error)
+	AbortMultipartUpload(ctx context.Context, params *s3.AbortMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.AbortMultipartUploadOutput, error)
+}
+
+// CleanupMultipartUploads aborts in-progress multipart uploads that were initiated more than
+// olderThan ago, releasing the storage held by their orphaned parts. It returns the number
+// of uploads aborted. Run it periodically as a maintenance job.
+//
+// Example:
+//
+//	aborted, err := s3Store.CleanupMultipartUploads(24 * time.Hour)
+func (s3s *S3Storage) CleanupMultipartUploads(olderThan time.Duration) (int, error) {
+	return cleanupMultipartUploads(context.TODO(), s3s.client, s3s.Config.Bucket, time.Now().Add(-olderThan))
+}
+
+// cleanupMultipartUploads aborts every multipart upload in bucket initiated before cutoff
+func cleanupMultipartUploads(ctx context.Context, api multipartAPI, bucket string, cutoff time.Time) (int, error) {
+	aborted := 0
+	input := &s3.ListMultipartUploadsInput{
+		Bucket: aws.String(bucket),
+	}
+
+	for {
+		output, err := api.ListMultipartUploads(ctx, input)
+		if err != nil {
+			return aborted, fmt.Errorf("failed to list multipart uploads in S3: %w", err)
+		}
+
+		for _, upload := range output.Uploads {
+			if upload.Initiated == nil || !upload.Initiated.Before(cutoff) {
+				continue
+			}
+
+			_, err := api.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+				Bucket:   aws.String(bucket),
+				Key:      upload.Key,
+				UploadId: upload.UploadId,
+			})
+			if err != nil {
+				return aborted, fmt.Errorf("failed to abort multipart upload %s: %w", aws.ToString(upload.Key), err)
+			}
+			aborted++
+		}
+
+		// ListMultipartUploads returns at most 1000 uploads per call
+		if !aws.ToBool(output.IsTruncated) {
+			return aborted, nil
+		}
+		input.KeyMarker = output.NextKeyMarker
+		input.UploadIdMarker = output.NextUploadIdMarker
+	}
 }
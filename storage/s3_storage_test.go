@@ -0,0 +1,305 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+func TestS3Storage_GetURL(t *testing.T) {
+	baseConfig := S3Config{
+		Region:          "us-east-1",
+		Bucket:          "public",
+		AccessKeyID:     "admin",
+		SecretAccessKey: "admin123",
+		EndpointURL:     "http://localhost:8333",
+		PublicURL:       "http://localhost:8888/buckets/public",
+	}
+
+	t.Run("cdn_host_configured", func(t *testing.T) {
+		config := baseConfig
+		config.CDNBaseURL = "https://cdn.example.com/assets/"
+		store := NewS3Storage(config)
+
+		url, err := store.GetURL("/images/photo.png")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if url != "https://cdn.example.com/assets/images/photo.png" {
+			t.Errorf("Expected CDN URL, got '%s'", url)
+		}
+	})
+
+	t.Run("fallback_to_public_url", func(t *testing.T) {
+		store := NewS3Storage(baseConfig)
+
+		url, err := store.GetURL("images/photo.png")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if url != "http://localhost:8888/buckets/public/images/photo.png" {
+			t.Errorf("Expected public URL, got '%s'", url)
+		}
+	})
+
+	t.Run("signed_url_ignores_cdn", func(t *testing.T) {
+		config := baseConfig
+		config.CDNBaseURL = "https://cdn.example.com"
+		store := NewS3Storage(config)
+
+		url, err := store.GetSignedURL("images/photo.png", 60)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(url, "http://localhost:8333/public/images/photo.png?") {
+			t.Errorf("Expected signed URL on origin endpoint, got '%s'", url)
+		}
+	})
+}
+
+func TestS3Storage_GetSignedUploadURL(t *testing.T) {
+	store := NewS3Storage(S3Config{
+		Region:          "us-east-1",
+		Bucket:          "public",
+		AccessKeyID:     "admin",
+		SecretAccessKey: "admin123",
+		EndpointURL:     "http://localhost:8333",
+		PublicURL:       "http://localhost:8888/buckets/public",
+	}).(*S3Storage)
+
+	url, err := store.GetSignedUploadURL("/uploads/../videos/clip.mp4", 300, "video/mp4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(url, "http://localhost:8333/public/videos/clip.mp4?") {
+		t.Errorf("Expected upload URL with cleaned key, got '%s'", url)
+	}
+	if !strings.Contains(url, "X-Amz-SignedHeaders=content-type%3Bhost") {
+		t.Errorf("Expected Content-Type to be part of the signature, got '%s'", url)
+	}
+	if !strings.Contains(url, "X-Amz-Expires=300") {
+		t.Errorf("Expected expiry of 300 seconds, got '%s'", url)
+	}
+}
+
+func TestS3Storage_SaveWithChecksum(t *testing.T) {
+	var gotChecksum, gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotChecksum = r.Header.Get("X-Amz-Checksum-Sha256")
+		gotPath = r.URL.Path
+		io.Copy(io.Discard, r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	store := NewS3Storage(S3Config{
+		Region:          "us-east-1",
+		Bucket:          "public",
+		AccessKeyID:     "admin",
+		SecretAccessKey: "admin123",
+		EndpointURL:     server.URL,
+	}).(*S3Storage)
+
+	source := filepath.Join(t.TempDir(), "report.txt")
+	if err := os.WriteFile(source, []byte("hello world"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	checksum, err := store.SaveWithChecksum(source, "/reports/report.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// base64(sha256("hello world"))
+	expected := "uU0nuZNNPgilLlLX2n2r+sSE7+N6U4DukIj3rOLvzek="
+	if checksum != expected {
+		t.Errorf("Expected checksum %q, got %q", expected, checksum)
+	}
+	if gotChecksum != expected {
+		t.Errorf("Expected checksum header %q, got %q", expected, gotChecksum)
+	}
+	if gotPath != "/public/reports/report.txt" {
+		t.Errorf("Expected cleaned key path, got %q", gotPath)
+	}
+}
+
+// fakeMultipartAPI serves ListMultipartUploads from pages and records aborted upload IDs
+type fakeMultipartAPI struct {
+	pages   []*s3.ListMultipartUploadsOutput
+	calls   int
+	aborted []string
+}
+
+func (f *fakeMultipartAPI) ListMultipartUploads(ctx context.Context, params *s3.ListMultipartUploadsInput, optFns ...func(*s3.Options)) (*s3.ListMultipartUploadsOutput, error) {
+	page := f.pages[f.calls]
+	if f.calls > 0 && aws.ToString(params.KeyMarker) != aws.ToString(f.pages[f.calls-1].NextKeyMarker) {
+		return nil, errors.New("unexpected key marker")
+	}
+	f.calls++
+	return page, nil
+}
+
+func (f *fakeMultipartAPI) AbortMultipartUpload(ctx context.Context, params *s3.AbortMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.AbortMultipartUploadOutput, error) {
+	f.aborted = append(f.aborted, aws.ToString(params.UploadId))
+	return &s3.AbortMultipartUploadOutput{}, nil
+}
+
+func TestCleanupMultipartUploads(t *testing.T) {
+	now := time.Now()
+	upload := func(id string, age time.Duration) types.MultipartUpload {
+		return types.MultipartUpload{
+			Key:       aws.String("videos/" + id + ".mp4"),
+			UploadId:  aws.String(id),
+			Initiated: aws.Time(now.Add(-age)),
+		}
+	}
+
+	api := &fakeMultipartAPI{
+		pages: []*s3.ListMultipartUploadsOutput{
+			{
+				Uploads:            []types.MultipartUpload{upload("stale-1", 48*time.Hour), upload("fresh-1", time.Hour)},
+				IsTruncated:        aws.Bool(true),
+				NextKeyMarker:      aws.String("videos/fresh-1.mp4"),
+				NextUploadIdMarker: aws.String("fresh-1"),
+			},
+			{
+				Uploads: []types.MultipartUpload{upload("stale-2", 25*time.Hour), upload("fresh-2", time.Minute)},
+			},
+		},
+	}
+
+	aborted, err := cleanupMultipartUploads(context.Background(), api, "public", now.Add(-24*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if aborted != 2 {
+		t.Errorf("Expected 2 uploads aborted, got %d", aborted)
+	}
+	if strings.Join(api.aborted, ",") != "stale-1,stale-2" {
+		t.Errorf("Expected only stale uploads to be aborted, got %v", api.aborted)
+	}
+	if api.calls != 2 {
+		t.Errorf("Expected both pages to be listed, got %d calls", api.calls)
+	}
+}
+
+// fakeS3Object is an object served by newFakeS3Server
+type fakeS3Object struct {
+	header http.Header
+}
+
+// newFakeS3Server serves HeadObject and CopyObject for objects in bucket "public".
+// A copy replaces the object's headers with those of the CopyObject request when the
+// metadata directive is REPLACE, like S3 does.
+func newFakeS3Server(t *testing.T, objects map[string]*fakeS3Object) *S3Storage {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(r.URL.Path, "/public/")
+
+		switch {
+		case r.Method == http.MethodHead:
+			obj, ok := objects[key]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			for k, v := range obj.header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(http.StatusOK)
+
+		case r.Method == http.MethodPut && r.Header.Get("X-Amz-Copy-Source") != "":
+			if r.Header.Get("X-Amz-Metadata-Directive") != "REPLACE" {
+				t.Errorf("Expected REPLACE metadata directive, got %q", r.Header.Get("X-Amz-Metadata-Directive"))
+			}
+			header := http.Header{}
+			for k, v := range r.Header {
+				lower := strings.ToLower(k)
+				if strings.HasPrefix(lower, "x-amz-meta-") || (strings.HasPrefix(lower, "content-") && lower != "content-length") ||
+					lower == "cache-control" || lower == "expires" {
+					header[k] = v
+				}
+			}
+			objects[key] = &fakeS3Object{header: header}
+			w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><CopyObjectResult><ETag>"etag"</ETag></CopyObjectResult>`))
+
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return NewS3Storage(S3Config{
+		Region:          "us-east-1",
+		Bucket:          "public",
+		AccessKeyID:     "admin",
+		SecretAccessKey: "admin123",
+		EndpointURL:     server.URL,
+	}).(*S3Storage)
+}
+
+func TestS3Storage_Metadata(t *testing.T) {
+	expires := "Wed, 21 Oct 2026 07:28:00 GMT"
+	objects := map[string]*fakeS3Object{
+		"docs/report.pdf": {header: http.Header{
+			"Content-Type":        {"application/pdf"},
+			"Cache-Control":       {"max-age=3600"},
+			"Content-Disposition": {`attachment; filename="report.pdf"`},
+			"Content-Encoding":    {"gzip"},
+			"Content-Language":    {"id"},
+			"Expires":             {expires},
+			"X-Amz-Meta-Owner":    {"alice"},
+		}},
+	}
+	store := newFakeS3Server(t, objects)
+
+	t.Run("set_keeps_system_headers", func(t *testing.T) {
+		if err := store.SetMetadata("/docs/report.pdf", map[string]string{"owner": "bob", "stage": "final"}); err != nil {
+			t.Fatalf("SetMetadata failed: %v", err)
+		}
+
+		header := objects["docs/report.pdf"].header
+		for name, want := range map[string]string{
+			"Content-Type":        "application/pdf",
+			"Cache-Control":       "max-age=3600",
+			"Content-Disposition": `attachment; filename="report.pdf"`,
+			"Content-Encoding":    "gzip",
+			"Content-Language":    "id",
+			"Expires":             expires,
+		} {
+			if got := header.Get(name); got != want {
+				t.Errorf("Expected %s %q to be kept, got %q", name, want, got)
+			}
+		}
+	})
+
+	t.Run("get_returns_user_metadata", func(t *testing.T) {
+		meta, err := store.GetMetadata("docs/report.pdf")
+		if err != nil {
+			t.Fatalf("GetMetadata failed: %v", err)
+		}
+		if len(meta) != 2 || meta["owner"] != "bob" || meta["stage"] != "final" {
+			t.Errorf("Expected replaced metadata, got %v", meta)
+		}
+	})
+
+	t.Run("missing_object", func(t *testing.T) {
+		if err := store.SetMetadata("missing.txt", map[string]string{"a": "b"}); !errors.Is(err, ErrObjectNotFound) {
+			t.Errorf("Expected ErrObjectNotFound from SetMetadata, got %v", err)
+		}
+		if _, err := store.GetMetadata("missing.txt"); !errors.Is(err, ErrObjectNotFound) {
+			t.Errorf("Expected ErrObjectNotFound from GetMetadata, got %v", err)
+		}
+	})
+}
@@ -19,20 +19,71 @@ func (s *Storage) Save(sourceFile string, destination string) error {
 	return s.Storage.Save(sourceFile, destination)
 }
 
+// SaveWithOptions uploads a file from sourceFile path to the destination path using the given options.
+func (s *Storage) SaveWithOptions(sourceFile string, destination string, options SaveOptions) error {
+	return s.Storage.SaveWithOptions(sourceFile, destination, options)
+}
+
 func (s *Storage) SaveFromReader(reader io.Reader, destination string) error {
 	return s.Storage.SaveFromReader(reader, destination)
 }
 
+// SaveReader streams the content of reader to the destination path in the storage system.
+// size is the content length in bytes; pass a negative value when the size is unknown.
+func (s *Storage) SaveReader(reader io.Reader, destination string, size int64) error {
+	return s.Storage.SaveReader(reader, destination, size)
+}
+
+// GetReader opens the file at the specified path for reading. The caller must close the reader.
+func (s *Storage) GetReader(path string) (io.ReadCloser, error) {
+	return s.Storage.GetReader(path)
+}
+
 // Delete removes the file at the specified path from the storage system.
 func (s *Storage) Delete(path string) error {
 	return s.Storage.Delete(path)
 }
 
+// DeleteMany removes all files at the specified paths from the storage system.
+func (s *Storage) DeleteMany(paths []string) error {
+	return s.Storage.DeleteMany(paths)
+}
+
+// Copy duplicates the file at src to dst within the storage system.
+func (s *Storage) Copy(src string, dst string) error {
+	return s.Storage.Copy(src, dst)
+}
+
+// Move relocates the file at src to dst within the storage system.
+func (s *Storage) Move(src string, dst string) error {
+	return s.Storage.Move(src, dst)
+}
+
 // Exists checks if a file exists at the specified path in the storage system.
 func (s *Storage) Exists(path string) (bool, error) {
 	return s.Storage.Exists(path)
 }
 
+// Size returns the size in bytes of the file at the specified path.
+func (s *Storage) Size(path string) (int64, error) {
+	return s.Storage.Size(path)
+}
+
+// SetMetadata replaces the user metadata stored with the file at the specified path.
+func (s *Storage) SetMetadata(path string, meta map[string]string) error {
+	return s.Storage.SetMetadata(path, meta)
+}
+
+// GetMetadata returns the user metadata stored with the file at the specified path.
+func (s *Storage) GetMetadata(path string) (map[string]string, error) {
+	return s.Storage.GetMetadata(path)
+}
+
+// List returns all files whose path starts with prefix.
+func (s *Storage) List(prefix string) ([]FileInfo, error) {
+	return s.Storage.List(prefix)
+}
+
 // GetURL generates a publicly accessible URL for the file at the specified path.
 func (s *Storage) GetURL(path string) (string, error) {
 	return s.Storage.GetURL(path)
@@ -0,0 +1,40 @@
+// Package tenant holds the request-scoped tenant ID shared by the HTTP and
+// persistence layers, so neither has to import the other.
+package tenant
+
+import "github.com/gofiber/fiber/v2"
+
+// LocalKey is the context locals key holding the resolved tenant ID
+const LocalKey = "tenant_id"
+
+// ID returns the tenant ID stored in the request context,
+// or an empty string if none was set.
+//
+// Parameters:
+//   - c: *fiber.Ctx - The Fiber context
+//
+// Returns:
+//   - string: The tenant ID, or "" when not set
+//
+// Example:
+//
+//	app.Get("/orders", func(c *fiber.Ctx) error {
+//	    tenantID := tenant.ID(c) // "acme"
+//	    ...
+//	})
+func ID(c *fiber.Ctx) string {
+	if tenantID, ok := c.Locals(LocalKey).(string); ok {
+		return tenantID
+	}
+	return ""
+}
+
+// SetID stores the tenant ID in the request context, usually from a middleware
+// such as response.TenantMiddleware.
+//
+// Parameters:
+//   - c: *fiber.Ctx - The Fiber context
+//   - tenantID: The resolved tenant identifier
+func SetID(c *fiber.Ctx, tenantID string) {
+	c.Locals(LocalKey, tenantID)
+}
@@ -0,0 +1,31 @@
+package tenant
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestID(t *testing.T) {
+	app := fiber.New()
+	app.Get("/unset", func(c *fiber.Ctx) error {
+		return c.SendString(ID(c))
+	})
+	app.Get("/set", func(c *fiber.Ctx) error {
+		SetID(c, "acme")
+		return c.SendString(ID(c))
+	})
+
+	for path, expected := range map[string]string{"/unset": "", "/set": "acme"} {
+		resp, err := app.Test(httptest.NewRequest("GET", path, nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		body := make([]byte, 16)
+		n, _ := resp.Body.Read(body)
+		if string(body[:n]) != expected {
+			t.Errorf("%s: expected %q, got %q", path, expected, body[:n])
+		}
+	}
+}
@@ -1,10 +1,17 @@
 package types
 
 import (
+	"database/sql/driver"
 	"fmt"
 	"time"
 )
 
+// TimeFormat is the layout used by UTCTime.MarshalJSON and UTCTime.String.
+// Change it once at startup (e.g., to "2006-01-02 15:04:05") for clients that don't
+// accept RFC3339; UnmarshalJSON accepts both RFC3339 and TimeFormat.
+// Default: time.RFC3339
+var TimeFormat = time.RFC3339
+
 // UTCTime is a custom time type that provides automatic UTC conversion for JSON serialization.
 // It ensures all time values are stored and transmitted in UTC timezone with RFC3339 format.
 //
@@ -37,9 +44,9 @@ import (
 type UTCTime time.Time
 
 // MarshalJSON implements the json.Marshaler interface for UTCTime.
-// It converts the time to UTC and formats it as RFC3339 with 'Z' suffix.
+// It converts the time to UTC and formats it with TimeFormat (RFC3339 with 'Z' suffix by default).
 //
-// The output format is always: "YYYY-MM-DDTHH:MM:SSZ"
+// The default output format is: "YYYY-MM-DDTHH:MM:SSZ"
 // Regardless of the original timezone, the time is converted to UTC before marshaling.
 //
 // Returns:
@@ -52,11 +59,13 @@ type UTCTime time.Time
 //	json, _ := t.MarshalJSON()
 //	// Output: []byte(`"2025-10-15T04:56:56Z"`)
 func (t UTCTime) MarshalJSON() ([]byte, error) {
-	// Konversi tipe kustom kembali ke time.Time
-	regularTime := time.Time(t)
+	return t.marshalJSON(TimeFormat)
+}
 
-	// Format ke UTC dengan format RFC3339 (yang menghasilkan 'Z')
-	formatted := regularTime.UTC().Format(time.RFC3339)
+// marshalJSON formats t in UTC with layout as a JSON string
+func (t UTCTime) marshalJSON(layout string) ([]byte, error) {
+	// Format ke UTC dengan layout yang diminta
+	formatted := time.Time(t).UTC().Format(layout)
 
 	// JSON string harus dalam tanda kutip, jadi kita tambahkan secara manual.
 	return []byte(fmt.Sprintf(`"%s"`, formatted)), nil
@@ -69,12 +78,14 @@ func (t UTCTime) MarshalJSON() ([]byte, error) {
 //   - "2025-10-15T04:56:56Z" (UTC with Z suffix)
 //   - "2025-10-15T04:56:56+07:00" (with timezone offset)
 //   - Any valid RFC3339 format
+//   - TimeFormat, when changed (values without a timezone are treated as UTC)
+//   - null or "" (empty string), which set the zero time
 //
 // Parameters:
 //   - data: JSON-encoded time string with quotes (e.g., []byte(`"2025-10-15T04:56:56Z"`))
 //
 // Returns:
-//   - error: Error if the data cannot be parsed as RFC3339 format
+//   - error: Error if the data is neither empty nor parseable as RFC3339 or TimeFormat
 //
 // Example:
 //
@@ -83,21 +94,37 @@ func (t UTCTime) MarshalJSON() ([]byte, error) {
 //	err := t.UnmarshalJSON(data)
 //	// t now contains the parsed time
 func (t *UTCTime) UnmarshalJSON(data []byte) error {
+	return t.unmarshalJSON(data, time.RFC3339, TimeFormat)
+}
+
+// unmarshalJSON parses data with the first matching layout
+func (t *UTCTime) unmarshalJSON(data []byte, layouts ...string) error {
+	// Upstream systems send null or "" for optional timestamps
+	if str := string(data); str == "null" || str == `""` {
+		*t = UTCTime(time.Time{})
+		return nil
+	}
+
 	// data adalah string JSON dengan tanda kutip, misal: []byte(`"2025-10-15T04:56:56Z"`)
-	// Kita perlu menghapus tanda kutip sebelum mem-parsing.
-	// time.RFC3339 sudah mengharapkan format seperti itu.
-	parsedTime, err := time.Parse(`"`+time.RFC3339+`"`, string(data))
-	if err != nil {
-		return err
+	// Tanda kutip ditambahkan ke layout supaya tidak perlu dihapus sebelum mem-parsing.
+	var firstErr error
+	for _, layout := range layouts {
+		parsedTime, err := time.Parse(`"`+layout+`"`, string(data))
+		if err == nil {
+			*t = UTCTime(parsedTime)
+			return nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
 	}
-	*t = UTCTime(parsedTime)
-	return nil
+	return firstErr
 }
 
-// String returns a string representation of UTCTime in RFC3339 format with UTC timezone.
+// String returns a string representation of UTCTime in UTC, formatted with TimeFormat.
 // This method is useful for logging, debugging, and displaying time values.
 //
-// The output format is always: "YYYY-MM-DDTHH:MM:SSZ"
+// The default output format is: "YYYY-MM-DDTHH:MM:SSZ"
 //
 // Returns:
 //   - string: Time formatted as RFC3339 in UTC (e.g., "2025-10-15T04:56:56Z")
@@ -108,6 +135,169 @@ func (t *UTCTime) UnmarshalJSON(data []byte) error {
 //	fmt.Println(t.String())
 //	// Output: 2025-10-15T04:56:56Z
 func (t UTCTime) String() string {
-	// Format ke UTC dengan RFC3339, sama seperti di MarshalJSON
-	return time.Time(t).UTC().Format(time.RFC3339)
+	// Format ke UTC dengan TimeFormat, sama seperti di MarshalJSON
+	return time.Time(t).UTC().Format(TimeFormat)
+}
+
+// scanLayouts are the text formats accepted by Scan, covering common database drivers
+var scanLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+// Value implements the driver.Valuer interface for UTCTime, so it can be written to
+// SQL timestamp columns (e.g., as a GORM model field). The time is stored in UTC.
+//
+// Returns:
+//   - driver.Value: The underlying time.Time in UTC
+//   - error: Always nil
+//
+// Example:
+//
+//	db.Exec("INSERT INTO events (created_at) VALUES (?)", types.UTCTime(time.Now()))
+func (t UTCTime) Value() (driver.Value, error) {
+	return time.Time(t).UTC(), nil
+}
+
+// Scan implements the sql.Scanner interface for UTCTime, so it can be read from
+// SQL timestamp columns. The scanned time is converted to UTC.
+//
+// Accepted source types:
+//   - time.Time: Used as is
+//   - []byte, string: Parsed as RFC3339 or "2006-01-02 15:04:05[.fraction][-07:00]";
+//     values without a timezone are treated as UTC
+//   - nil: Sets the zero time
+//
+// Parameters:
+//   - src: Value from the database driver
+//
+// Returns:
+//   - error: Error if src has an unsupported type or cannot be parsed
+//
+// Example:
+//
+//	var createdAt types.UTCTime
+//	err := db.QueryRow("SELECT created_at FROM events WHERE id = ?", id).Scan(&createdAt)
+func (t *UTCTime) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*t = UTCTime(time.Time{})
+		return nil
+	case time.Time:
+		*t = UTCTime(v.UTC())
+		return nil
+	case []byte:
+		return t.scanString(string(v))
+	case string:
+		return t.scanString(v)
+	default:
+		return fmt.Errorf("types: cannot scan %T into UTCTime", src)
+	}
+}
+
+// scanString parses s with the first matching layout in scanLayouts
+func (t *UTCTime) scanString(s string) error {
+	for _, layout := range scanLayouts {
+		if parsed, err := time.Parse(layout, s); err == nil {
+			*t = UTCTime(parsed.UTC())
+			return nil
+		}
+	}
+	return fmt.Errorf("types: cannot parse %q as UTCTime", s)
+}
+
+// TruncateToDay returns the start of the UTC day (00:00:00) containing t.
+//
+// Example:
+//
+//	t := UTCTime(time.Date(2025, 10, 15, 4, 56, 56, 0, time.UTC))
+//	fmt.Println(t.TruncateToDay())
+//	// Output: 2025-10-15T00:00:00Z
+func (t UTCTime) TruncateToDay() UTCTime {
+	u := time.Time(t).UTC()
+	return UTCTime(time.Date(u.Year(), u.Month(), u.Day(), 0, 0, 0, 0, time.UTC))
+}
+
+// TruncateToHour returns t rounded down to the start of its UTC hour.
+//
+// Example:
+//
+//	t := UTCTime(time.Date(2025, 10, 15, 4, 56, 56, 0, time.UTC))
+//	fmt.Println(t.TruncateToHour())
+//	// Output: 2025-10-15T04:00:00Z
+func (t UTCTime) TruncateToHour() UTCTime {
+	return UTCTime(time.Time(t).UTC().Truncate(time.Hour))
+}
+
+// TruncateToMinute returns t rounded down to the start of its UTC minute.
+//
+// Example:
+//
+//	t := UTCTime(time.Date(2025, 10, 15, 4, 56, 56, 0, time.UTC))
+//	fmt.Println(t.TruncateToMinute())
+//	// Output: 2025-10-15T04:56:00Z
+func (t UTCTime) TruncateToMinute() UTCTime {
+	return UTCTime(time.Time(t).UTC().Truncate(time.Minute))
+}
+
+// Before reports whether t is before o.
+//
+// Example:
+//
+//	if expiresAt.Before(types.UTCTime(time.Now())) {
+//	    return ErrExpired
+//	}
+func (t UTCTime) Before(o UTCTime) bool {
+	return time.Time(t).Before(time.Time(o))
+}
+
+// After reports whether t is after o.
+//
+// Example:
+//
+//	if endAt.After(startAt) {
+//	    // valid range
+//	}
+func (t UTCTime) After(o UTCTime) bool {
+	return time.Time(t).After(time.Time(o))
+}
+
+// Equal reports whether t and o represent the same instant, regardless of their timezones.
+//
+// Example:
+//
+//	jakarta := time.FixedZone("WIB", 7*60*60)
+//	a := UTCTime(time.Date(2025, 10, 15, 11, 56, 56, 0, jakarta))
+//	b := UTCTime(time.Date(2025, 10, 15, 4, 56, 56, 0, time.UTC))
+//	fmt.Println(a.Equal(b))
+//	// Output: true
+func (t UTCTime) Equal(o UTCTime) bool {
+	return time.Time(t).Equal(time.Time(o))
+}
+
+// Add returns t+d in UTC.
+//
+// Example:
+//
+//	t := UTCTime(time.Date(2025, 10, 15, 4, 56, 56, 0, time.UTC))
+//	fmt.Println(t.Add(24 * time.Hour))
+//	// Output: 2025-10-16T04:56:56Z
+func (t UTCTime) Add(d time.Duration) UTCTime {
+	return UTCTime(time.Time(t).UTC().Add(d))
+}
+
+// Sub returns the duration t-o.
+//
+// Example:
+//
+//	start := UTCTime(time.Date(2025, 10, 15, 4, 0, 0, 0, time.UTC))
+//	end := UTCTime(time.Date(2025, 10, 15, 5, 30, 0, 0, time.UTC))
+//	fmt.Println(end.Sub(start))
+//	// Output: 1h30m0s
+func (t UTCTime) Sub(o UTCTime) time.Duration {
+	return time.Time(t).Sub(time.Time(o))
 }
@@ -0,0 +1,78 @@
+package types
+
+import "time"
+
+// UTCTimeWithFormat is a UTCTime that marshals with its own layout instead of the
+// package-level TimeFormat, for fields whose clients expect a different format.
+//
+// Fields:
+//   - Time: The time value, always marshaled in UTC
+//   - Format: Layout used by MarshalJSON and String (defaults to TimeFormat when empty)
+//
+// UnmarshalJSON accepts RFC3339, TimeFormat and Format, and keeps Format, so set it
+// before unmarshaling to parse values written in a custom layout.
+//
+// Example:
+//
+//	type Invoice struct {
+//	    IssuedAt types.UTCTime           `json:"issued_at"`
+//	    DueAt    types.UTCTimeWithFormat `json:"due_at"`
+//	}
+//
+//	invoice := Invoice{
+//	    IssuedAt: types.UTCTime(time.Now()),
+//	    DueAt:    types.NewUTCTimeWithFormat(time.Now().Add(72*time.Hour), "2006-01-02 15:04:05"),
+//	}
+//	// When marshaled to JSON:
+//	// {"issued_at":"2025-10-15T04:56:56Z","due_at":"2025-10-18 04:56:56"}
+type UTCTimeWithFormat struct {
+	Time   UTCTime
+	Format string
+}
+
+// NewUTCTimeWithFormat creates a UTCTimeWithFormat from t with the given layout.
+//
+// Parameters:
+//   - t: The time value
+//   - format: Layout used for marshaling (e.g., "2006-01-02 15:04:05")
+//
+// Returns:
+//   - UTCTimeWithFormat: The wrapped time
+func NewUTCTimeWithFormat(t time.Time, format string) UTCTimeWithFormat {
+	return UTCTimeWithFormat{Time: UTCTime(t), Format: format}
+}
+
+// layout returns Format, or TimeFormat when Format is empty
+func (t UTCTimeWithFormat) layout() string {
+	if t.Format == "" {
+		return TimeFormat
+	}
+	return t.Format
+}
+
+// MarshalJSON implements the json.Marshaler interface for UTCTimeWithFormat.
+// It converts the time to UTC and formats it with Format.
+//
+// Returns:
+//   - []byte: JSON-encoded time string
+//   - error: Error if formatting fails (rarely occurs)
+func (t UTCTimeWithFormat) MarshalJSON() ([]byte, error) {
+	return t.Time.marshalJSON(t.layout())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for UTCTimeWithFormat.
+// It accepts RFC3339, TimeFormat and Format, plus null or "" for the zero time.
+//
+// Parameters:
+//   - data: JSON-encoded time string
+//
+// Returns:
+//   - error: Error if the data matches none of the accepted layouts
+func (t *UTCTimeWithFormat) UnmarshalJSON(data []byte) error {
+	return t.Time.unmarshalJSON(data, time.RFC3339, TimeFormat, t.layout())
+}
+
+// String returns the time in UTC, formatted with Format.
+func (t UTCTimeWithFormat) String() string {
+	return time.Time(t.Time).UTC().Format(t.layout())
+}
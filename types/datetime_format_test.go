@@ -0,0 +1,136 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+const mysqlFormat = "2006-01-02 15:04:05"
+
+func TestTimeFormat(t *testing.T) {
+	saved := TimeFormat
+	defer func() { TimeFormat = saved }()
+
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	ut := UTCTime(time.Date(2025, 10, 15, 11, 56, 56, 0, jakarta))
+
+	t.Run("default_rfc3339", func(t *testing.T) {
+		TimeFormat = time.RFC3339
+		data, _ := json.Marshal(ut)
+		if string(data) != `"2025-10-15T04:56:56Z"` {
+			t.Errorf("Expected RFC3339, got %s", data)
+		}
+	})
+
+	t.Run("custom_format", func(t *testing.T) {
+		TimeFormat = mysqlFormat
+		data, _ := json.Marshal(ut)
+		if string(data) != `"2025-10-15 04:56:56"` {
+			t.Errorf("Expected custom format, got %s", data)
+		}
+		if ut.String() != "2025-10-15 04:56:56" {
+			t.Errorf("Expected String to use custom format, got %s", ut.String())
+		}
+	})
+
+	t.Run("unmarshal_accepts_both", func(t *testing.T) {
+		TimeFormat = mysqlFormat
+		expected := time.Date(2025, 10, 15, 4, 56, 56, 0, time.UTC)
+
+		for _, input := range []string{`"2025-10-15 04:56:56"`, `"2025-10-15T04:56:56Z"`, `"2025-10-15T11:56:56+07:00"`} {
+			var got UTCTime
+			if err := json.Unmarshal([]byte(input), &got); err != nil {
+				t.Fatalf("Unmarshal %s failed: %v", input, err)
+			}
+			if !time.Time(got).Equal(expected) {
+				t.Errorf("Unmarshal %s: expected %v, got %v", input, expected, time.Time(got))
+			}
+		}
+	})
+
+	t.Run("round_trip", func(t *testing.T) {
+		for _, format := range []string{time.RFC3339, mysqlFormat} {
+			TimeFormat = format
+			data, _ := json.Marshal(ut)
+			var decoded UTCTime
+			if err := json.Unmarshal(data, &decoded); err != nil {
+				t.Fatalf("Round trip with %q failed: %v", format, err)
+			}
+			if !decoded.Equal(ut) {
+				t.Errorf("Round trip with %q: expected %s, got %s", format, ut, decoded)
+			}
+		}
+	})
+
+	t.Run("rejects_other_formats", func(t *testing.T) {
+		TimeFormat = mysqlFormat
+		var got UTCTime
+		if err := json.Unmarshal([]byte(`"15/10/2025"`), &got); err == nil {
+			t.Error("Expected error for unknown format")
+		}
+	})
+}
+
+func TestUTCTimeWithFormat(t *testing.T) {
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	moment := time.Date(2025, 10, 15, 11, 56, 56, 0, jakarta)
+
+	type Invoice struct {
+		IssuedAt UTCTime           `json:"issued_at"`
+		DueAt    UTCTimeWithFormat `json:"due_at"`
+	}
+
+	t.Run("marshal_per_field", func(t *testing.T) {
+		invoice := Invoice{
+			IssuedAt: UTCTime(moment),
+			DueAt:    NewUTCTimeWithFormat(moment, mysqlFormat),
+		}
+		data, err := json.Marshal(invoice)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := `{"issued_at":"2025-10-15T04:56:56Z","due_at":"2025-10-15 04:56:56"}`
+		if string(data) != expected {
+			t.Errorf("Expected %s, got %s", expected, data)
+		}
+	})
+
+	t.Run("empty_format_uses_time_format", func(t *testing.T) {
+		wf := UTCTimeWithFormat{Time: UTCTime(moment)}
+		if wf.String() != "2025-10-15T04:56:56Z" {
+			t.Errorf("Expected TimeFormat, got %s", wf.String())
+		}
+	})
+
+	t.Run("unmarshal_custom_format", func(t *testing.T) {
+		wf := UTCTimeWithFormat{Format: "02/01/2006 15:04"}
+		if err := json.Unmarshal([]byte(`"15/10/2025 04:56"`), &wf); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		expected := time.Date(2025, 10, 15, 4, 56, 0, 0, time.UTC)
+		if !time.Time(wf.Time).Equal(expected) {
+			t.Errorf("Expected %v, got %v", expected, time.Time(wf.Time))
+		}
+		if wf.Format != "02/01/2006 15:04" {
+			t.Errorf("Expected Format to be kept, got %q", wf.Format)
+		}
+
+		// RFC3339 is accepted too
+		if err := json.Unmarshal([]byte(`"2025-10-15T04:56:00Z"`), &wf); err != nil {
+			t.Fatalf("Unmarshal RFC3339 failed: %v", err)
+		}
+	})
+
+	t.Run("round_trip", func(t *testing.T) {
+		original := NewUTCTimeWithFormat(moment, mysqlFormat)
+		data, _ := json.Marshal(original)
+		decoded := UTCTimeWithFormat{Format: mysqlFormat}
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if !decoded.Time.Equal(original.Time) {
+			t.Errorf("Expected %s, got %s", original, decoded)
+		}
+	})
+}
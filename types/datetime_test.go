@@ -1,6 +1,8 @@
 package types
 
 import (
+	"database/sql"
+	"database/sql/driver"
 	"encoding/json"
 	"strings"
 	"testing"
@@ -164,10 +166,43 @@ func TestUTCTimeUnmarshalJSON(t *testing.T) {
 	t.Run("unmarshal_empty_string", func(t *testing.T) {
 		jsonStr := `""`
 
-		var ut UTCTime
+		ut := UTCTime(time.Now())
 		err := json.Unmarshal([]byte(jsonStr), &ut)
-		if err == nil {
-			t.Error("Expected error for empty string")
+		if err != nil {
+			t.Fatalf("Expected empty string to unmarshal, got %v", err)
+		}
+		if !time.Time(ut).IsZero() {
+			t.Errorf("Expected zero time for empty string, got %v", time.Time(ut))
+		}
+	})
+
+	t.Run("unmarshal_null", func(t *testing.T) {
+		ut := UTCTime(time.Now())
+		if err := ut.UnmarshalJSON([]byte(`null`)); err != nil {
+			t.Fatalf("Expected null to unmarshal, got %v", err)
+		}
+		if !time.Time(ut).IsZero() {
+			t.Errorf("Expected zero time for null, got %v", time.Time(ut))
+		}
+
+		// As a struct field, too
+		var payload struct {
+			ExpiresAt UTCTime `json:"expires_at"`
+		}
+		if err := json.Unmarshal([]byte(`{"expires_at": null}`), &payload); err != nil {
+			t.Fatalf("Expected null field to unmarshal, got %v", err)
+		}
+		if !time.Time(payload.ExpiresAt).IsZero() {
+			t.Errorf("Expected zero time, got %v", time.Time(payload.ExpiresAt))
+		}
+	})
+
+	t.Run("unmarshal_empty_is_not_lenient_for_garbage", func(t *testing.T) {
+		for _, input := range []string{`"not a time"`, `" "`, `"2025-10-15"`, `0`} {
+			var ut UTCTime
+			if err := json.Unmarshal([]byte(input), &ut); err == nil {
+				t.Errorf("Expected error for %s", input)
+			}
 		}
 	})
 
@@ -517,3 +552,222 @@ func TestUTCTimeComparison(t *testing.T) {
 		}
 	})
 }
+
+// ============================================================================
+// Truncate Tests
+// ============================================================================
+
+func TestUTCTimeCompareMethods(t *testing.T) {
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	base := UTCTime(time.Date(2025, 10, 15, 12, 30, 45, 0, time.UTC))
+	sameInstant := UTCTime(time.Date(2025, 10, 15, 19, 30, 45, 0, jakarta))
+	later := UTCTime(time.Date(2025, 10, 15, 12, 30, 46, 0, time.UTC))
+
+	if !base.Equal(sameInstant) {
+		t.Error("Same instant in different timezones should be equal")
+	}
+	if base.Equal(later) {
+		t.Error("Different times should not be equal")
+	}
+	if !base.Before(later) || later.Before(base) {
+		t.Error("Expected base to be before later")
+	}
+	if !later.After(base) || base.After(later) {
+		t.Error("Expected later to be after base")
+	}
+	if base.Before(sameInstant) || base.After(sameInstant) {
+		t.Error("Same instant should be neither before nor after")
+	}
+}
+
+func TestUTCTimeArithmetic(t *testing.T) {
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	start := UTCTime(time.Date(2025, 10, 15, 11, 0, 0, 0, jakarta)) // 04:00 UTC
+
+	t.Run("add_returns_utc", func(t *testing.T) {
+		got := start.Add(90 * time.Minute)
+		if time.Time(got).Location() != time.UTC {
+			t.Errorf("Expected UTC location, got %v", time.Time(got).Location())
+		}
+		if got.String() != "2025-10-15T05:30:00Z" {
+			t.Errorf("Expected 2025-10-15T05:30:00Z, got %s", got)
+		}
+	})
+
+	t.Run("add_negative_crosses_day", func(t *testing.T) {
+		if got := start.Add(-5 * time.Hour).String(); got != "2025-10-14T23:00:00Z" {
+			t.Errorf("Expected 2025-10-14T23:00:00Z, got %s", got)
+		}
+	})
+
+	t.Run("sub", func(t *testing.T) {
+		end := UTCTime(time.Date(2025, 10, 15, 5, 30, 0, 0, time.UTC))
+		if d := end.Sub(start); d != 90*time.Minute {
+			t.Errorf("Expected 1h30m, got %v", d)
+		}
+		if d := start.Sub(end); d != -90*time.Minute {
+			t.Errorf("Expected -1h30m, got %v", d)
+		}
+	})
+
+	t.Run("add_sub_round_trip", func(t *testing.T) {
+		for _, d := range []time.Duration{0, time.Nanosecond, time.Hour, -36 * time.Hour, 400 * 24 * time.Hour} {
+			moved := start.Add(d)
+			if got := moved.Sub(start); got != d {
+				t.Errorf("Sub(Add(%v)) = %v", d, got)
+			}
+			if back := moved.Add(-d); !back.Equal(start) {
+				t.Errorf("Add(%v).Add(-%v) = %s, expected %s", d, d, back, start)
+			}
+		}
+	})
+
+	t.Run("json_round_trip", func(t *testing.T) {
+		expiresAt := start.Add(24 * time.Hour)
+		data, err := json.Marshal(expiresAt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded UTCTime
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if !decoded.Equal(expiresAt) || decoded.Sub(start) != 24*time.Hour {
+			t.Errorf("Expected %s after round trip, got %s", expiresAt, decoded)
+		}
+	})
+}
+
+func TestUTCTimeTruncate(t *testing.T) {
+	ut := UTCTime(time.Date(2025, 10, 15, 12, 30, 45, 123456789, time.UTC))
+
+	tests := []struct {
+		name     string
+		got      UTCTime
+		expected time.Time
+	}{
+		{"day", ut.TruncateToDay(), time.Date(2025, 10, 15, 0, 0, 0, 0, time.UTC)},
+		{"hour", ut.TruncateToHour(), time.Date(2025, 10, 15, 12, 0, 0, 0, time.UTC)},
+		{"minute", ut.TruncateToMinute(), time.Date(2025, 10, 15, 12, 30, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := time.Time(tt.got)
+			if !got.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+			if got.Location() != time.UTC {
+				t.Errorf("Expected UTC location, got %v", got.Location())
+			}
+		})
+	}
+
+	t.Run("day_boundary_in_utc", func(t *testing.T) {
+		// 03:00 in Jakarta on the 16th is still the 15th in UTC
+		jakarta := time.FixedZone("WIB", 7*60*60)
+		local := UTCTime(time.Date(2025, 10, 16, 3, 15, 0, 0, jakarta))
+
+		expected := time.Date(2025, 10, 15, 0, 0, 0, 0, time.UTC)
+		if got := time.Time(local.TruncateToDay()); !got.Equal(expected) {
+			t.Errorf("Expected %v, got %v", expected, got)
+		}
+	})
+
+	t.Run("half_hour_offset", func(t *testing.T) {
+		// Truncation happens on UTC boundaries, not local ones
+		india := time.FixedZone("IST", 5*60*60+30*60)
+		local := UTCTime(time.Date(2025, 10, 15, 10, 45, 0, 0, india)) // 05:15 UTC
+
+		expected := time.Date(2025, 10, 15, 5, 0, 0, 0, time.UTC)
+		if got := time.Time(local.TruncateToHour()); !got.Equal(expected) {
+			t.Errorf("Expected %v, got %v", expected, got)
+		}
+	})
+}
+
+// ============================================================================
+// SQL Value / Scan Tests
+// ============================================================================
+
+func TestUTCTimeValue(t *testing.T) {
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	ut := UTCTime(time.Date(2025, 10, 15, 11, 56, 56, 0, jakarta))
+
+	// Compile-time interface checks
+	var _ driver.Valuer = ut
+	var _ sql.Scanner = &ut
+
+	v, err := ut.Value()
+	if err != nil {
+		t.Fatalf("Value failed: %v", err)
+	}
+	got, ok := v.(time.Time)
+	if !ok {
+		t.Fatalf("Expected time.Time, got %T", v)
+	}
+	if !got.Equal(time.Date(2025, 10, 15, 4, 56, 56, 0, time.UTC)) || got.Location() != time.UTC {
+		t.Errorf("Expected 2025-10-15 04:56:56 UTC, got %v", got)
+	}
+}
+
+func TestUTCTimeScan(t *testing.T) {
+	expected := time.Date(2025, 10, 15, 4, 56, 56, 0, time.UTC)
+	jakarta := time.FixedZone("WIB", 7*60*60)
+
+	tests := []struct {
+		name     string
+		src      interface{}
+		expected time.Time
+	}{
+		{"time", time.Date(2025, 10, 15, 11, 56, 56, 0, jakarta), expected},
+		{"rfc3339_string", "2025-10-15T04:56:56Z", expected},
+		{"rfc3339_bytes", []byte("2025-10-15T11:56:56+07:00"), expected},
+		{"mysql_datetime", "2025-10-15 04:56:56", expected},
+		{"postgres_text", []byte("2025-10-15 11:56:56.5+07:00"), expected.Add(500 * time.Millisecond)},
+		{"date_only", "2025-10-15", time.Date(2025, 10, 15, 0, 0, 0, 0, time.UTC)},
+		{"nil", nil, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ut := UTCTime(time.Now())
+			if err := ut.Scan(tt.src); err != nil {
+				t.Fatalf("Scan failed: %v", err)
+			}
+			got := time.Time(ut)
+			if !got.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+			if !got.IsZero() && got.Location() != time.UTC {
+				t.Errorf("Expected UTC location, got %v", got.Location())
+			}
+		})
+	}
+
+	t.Run("invalid_string", func(t *testing.T) {
+		var ut UTCTime
+		if err := ut.Scan("not a time"); err == nil {
+			t.Error("Expected error for invalid string")
+		}
+	})
+
+	t.Run("unsupported_type", func(t *testing.T) {
+		var ut UTCTime
+		if err := ut.Scan(12345); err == nil {
+			t.Error("Expected error for unsupported type")
+		}
+	})
+
+	t.Run("round_trip", func(t *testing.T) {
+		original := UTCTime(expected)
+		v, _ := original.Value()
+		var scanned UTCTime
+		if err := scanned.Scan(v); err != nil {
+			t.Fatal(err)
+		}
+		if !time.Time(scanned).Equal(expected) {
+			t.Errorf("Expected %v, got %v", expected, time.Time(scanned))
+		}
+	})
+}
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"bytes"
+	"database/sql/driver"
+)
+
+// NullUTCTime represents a UTCTime that may be null, following the sql.NullTime pattern.
+// It keeps the UTC normalization of UTCTime for valid values.
+//
+// Fields:
+//   - Time: The time value, meaningful only when Valid is true
+//   - Valid: Whether Time is set (false means NULL / JSON null)
+//
+// Example:
+//
+//	type User struct {
+//	    ID        uint              `json:"id"`
+//	    DeletedAt types.NullUTCTime `json:"deleted_at"`
+//	}
+//
+//	user := User{ID: 1}
+//	// When marshaled to JSON:
+//	// {"id":1,"deleted_at":null}
+//
+//	user.DeletedAt = types.NullUTCTime{Time: types.UTCTime(time.Now()), Valid: true}
+//	// {"id":1,"deleted_at":"2025-10-15T04:56:56Z"}
+type NullUTCTime struct {
+	Time  UTCTime
+	Valid bool
+}
+
+// MarshalJSON implements the json.Marshaler interface for NullUTCTime.
+// It returns null when the value is not valid, otherwise the UTCTime JSON format.
+//
+// Returns:
+//   - []byte: `null` or a JSON-encoded UTC string in TimeFormat
+//   - error: Error if formatting fails (rarely occurs)
+func (t NullUTCTime) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return []byte("null"), nil
+	}
+	return t.Time.MarshalJSON()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for NullUTCTime.
+// null and the empty string "" set Valid to false; any other value is parsed like UTCTime.
+//
+// Parameters:
+//   - data: JSON-encoded time string, null, or ""
+//
+// Returns:
+//   - error: Error if the data cannot be parsed as RFC3339 format
+func (t *NullUTCTime) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) || bytes.Equal(data, []byte(`""`)) {
+		*t = NullUTCTime{}
+		return nil
+	}
+
+	if err := t.Time.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	t.Valid = true
+	return nil
+}
+
+// Value implements the driver.Valuer interface for NullUTCTime.
+// It returns nil (NULL) when the value is not valid, otherwise the time in UTC.
+//
+// Returns:
+//   - driver.Value: nil or the underlying time.Time in UTC
+//   - error: Always nil
+func (t NullUTCTime) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.Time.Value()
+}
+
+// Scan implements the sql.Scanner interface for NullUTCTime.
+// A NULL column sets Valid to false; other values are scanned like UTCTime.
+//
+// Parameters:
+//   - src: Value from the database driver
+//
+// Returns:
+//   - error: Error if src has an unsupported type or cannot be parsed
+//
+// Example:
+//
+//	var deletedAt types.NullUTCTime
+//	err := db.QueryRow("SELECT deleted_at FROM users WHERE id = ?", id).Scan(&deletedAt)
+//	if deletedAt.Valid {
+//	    fmt.Println("deleted at", deletedAt.Time)
+//	}
+func (t *NullUTCTime) Scan(src interface{}) error {
+	if src == nil {
+		*t = NullUTCTime{}
+		return nil
+	}
+
+	if err := t.Time.Scan(src); err != nil {
+		t.Valid = false
+		return err
+	}
+	t.Valid = true
+	return nil
+}
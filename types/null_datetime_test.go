@@ -0,0 +1,138 @@
+package types
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestNullUTCTimeMarshalJSON(t *testing.T) {
+	jakarta := time.FixedZone("WIB", 7*60*60)
+
+	tests := []struct {
+		name     string
+		input    NullUTCTime
+		expected string
+	}{
+		{"invalid", NullUTCTime{}, `null`},
+		{"invalid_with_time", NullUTCTime{Time: UTCTime(time.Now())}, `null`},
+		{"valid", NullUTCTime{Time: UTCTime(time.Date(2025, 10, 15, 11, 56, 56, 0, jakarta)), Valid: true}, `"2025-10-15T04:56:56Z"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.input)
+			if err != nil {
+				t.Fatalf("Marshal failed: %v", err)
+			}
+			if string(data) != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, data)
+			}
+		})
+	}
+
+	t.Run("struct_field", func(t *testing.T) {
+		type User struct {
+			DeletedAt NullUTCTime `json:"deleted_at"`
+		}
+		data, _ := json.Marshal(User{})
+		if string(data) != `{"deleted_at":null}` {
+			t.Errorf("Expected null deleted_at, got %s", data)
+		}
+	})
+}
+
+func TestNullUTCTimeUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		valid     bool
+		expected  time.Time
+		expectErr bool
+	}{
+		{"null", `null`, false, time.Time{}, false},
+		{"empty_string", `""`, false, time.Time{}, false},
+		{"utc", `"2025-10-15T04:56:56Z"`, true, time.Date(2025, 10, 15, 4, 56, 56, 0, time.UTC), false},
+		{"offset", `"2025-10-15T11:56:56+07:00"`, true, time.Date(2025, 10, 15, 4, 56, 56, 0, time.UTC), false},
+		{"invalid", `"yesterday"`, false, time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nt := NullUTCTime{Time: UTCTime(time.Now()), Valid: true}
+			err := json.Unmarshal([]byte(tt.input), &nt)
+			if tt.expectErr {
+				if err == nil {
+					t.Error("Expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unmarshal failed: %v", err)
+			}
+			if nt.Valid != tt.valid {
+				t.Errorf("Expected Valid=%v, got %v", tt.valid, nt.Valid)
+			}
+			if !time.Time(nt.Time).Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, time.Time(nt.Time))
+			}
+		})
+	}
+}
+
+func TestNullUTCTimeSQL(t *testing.T) {
+	var nt NullUTCTime
+	var _ driver.Valuer = nt
+	var _ sql.Scanner = &nt
+
+	t.Run("value_invalid", func(t *testing.T) {
+		v, err := NullUTCTime{}.Value()
+		if err != nil || v != nil {
+			t.Errorf("Expected nil value, got %v (%v)", v, err)
+		}
+	})
+
+	t.Run("value_valid", func(t *testing.T) {
+		jakarta := time.FixedZone("WIB", 7*60*60)
+		v, err := NullUTCTime{Time: UTCTime(time.Date(2025, 10, 15, 11, 56, 56, 0, jakarta)), Valid: true}.Value()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, ok := v.(time.Time)
+		if !ok || got.Location() != time.UTC || !got.Equal(time.Date(2025, 10, 15, 4, 56, 56, 0, time.UTC)) {
+			t.Errorf("Expected UTC time, got %v", v)
+		}
+	})
+
+	t.Run("scan_null", func(t *testing.T) {
+		nt := NullUTCTime{Time: UTCTime(time.Now()), Valid: true}
+		if err := nt.Scan(nil); err != nil {
+			t.Fatal(err)
+		}
+		if nt.Valid || !time.Time(nt.Time).IsZero() {
+			t.Errorf("Expected invalid zero value, got %+v", nt)
+		}
+	})
+
+	t.Run("scan_value", func(t *testing.T) {
+		var nt NullUTCTime
+		if err := nt.Scan("2025-10-15 04:56:56"); err != nil {
+			t.Fatal(err)
+		}
+		if !nt.Valid || !time.Time(nt.Time).Equal(time.Date(2025, 10, 15, 4, 56, 56, 0, time.UTC)) {
+			t.Errorf("Expected valid time, got %+v", nt)
+		}
+	})
+
+	t.Run("scan_error", func(t *testing.T) {
+		var nt NullUTCTime
+		if err := nt.Scan(3.14); err == nil {
+			t.Error("Expected error for unsupported type")
+		}
+		if nt.Valid {
+			t.Error("Expected Valid=false after failed scan")
+		}
+	})
+}
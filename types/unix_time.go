@@ -0,0 +1,158 @@
+package types
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// unixMillisThreshold is the magnitude from which an epoch value is read as milliseconds.
+// 1e12 seconds is year 33658, while 1e12 milliseconds is 2001-09-09, so any realistic
+// timestamp falls clearly on one side of it.
+const unixMillisThreshold = 1_000_000_000_000
+
+// UnixTime is a custom time type that serializes to JSON as integer epoch seconds,
+// for clients (e.g., mobile apps) that prefer numbers over RFC3339 strings.
+//
+// Features:
+//   - Marshals to epoch seconds (e.g., 1760504216); the zero time marshals to 0
+//   - Unmarshals epoch seconds or milliseconds, detected by magnitude
+//   - Implements driver.Valuer and sql.Scanner for SQL timestamp or integer columns
+//
+// Example:
+//
+//	type Session struct {
+//	    ID        int            `json:"id"`
+//	    ExpiresAt types.UnixTime `json:"expires_at"`
+//	}
+//
+//	session := Session{ID: 1, ExpiresAt: types.UnixTime(time.Now().Add(time.Hour))}
+//	// When marshaled to JSON:
+//	// {"id":1,"expires_at":1760507816}
+type UnixTime time.Time
+
+// MarshalJSON implements the json.Marshaler interface for UnixTime.
+// It encodes the time as integer epoch seconds; sub-second precision is dropped.
+//
+// Returns:
+//   - []byte: JSON number (e.g., []byte(`1760504216`)), or 0 for the zero time
+//   - error: Always nil
+func (t UnixTime) MarshalJSON() ([]byte, error) {
+	if time.Time(t).IsZero() {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatInt(time.Time(t).Unix(), 10)), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for UnixTime.
+// Values with an absolute value of at least 1e12 are read as milliseconds,
+// smaller values as seconds.
+//
+// Accepted values:
+//   - 1760504216 (epoch seconds)
+//   - 1760504216000 (epoch milliseconds)
+//   - 0, null or "" (empty string), which set the zero time
+//
+// Parameters:
+//   - data: JSON number
+//
+// Returns:
+//   - error: Error if the data is not an integer
+func (t *UnixTime) UnmarshalJSON(data []byte) error {
+	str := string(data)
+	if str == "null" || str == `""` {
+		*t = UnixTime(time.Time{})
+		return nil
+	}
+
+	n, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return fmt.Errorf("types: cannot parse %s as UnixTime", str)
+	}
+	*t = unixTimeFromInt(n)
+	return nil
+}
+
+// unixTimeFromInt converts epoch seconds or milliseconds to UnixTime in UTC
+func unixTimeFromInt(n int64) UnixTime {
+	if n == 0 {
+		return UnixTime(time.Time{})
+	}
+	if n >= unixMillisThreshold || n <= -unixMillisThreshold {
+		return UnixTime(time.UnixMilli(n).UTC())
+	}
+	return UnixTime(time.Unix(n, 0).UTC())
+}
+
+// String returns the epoch seconds of t, matching its JSON representation.
+func (t UnixTime) String() string {
+	if time.Time(t).IsZero() {
+		return "0"
+	}
+	return strconv.FormatInt(time.Time(t).Unix(), 10)
+}
+
+// Value implements the driver.Valuer interface for UnixTime.
+// Like UTCTime, it writes the time in UTC so it fits SQL timestamp columns.
+//
+// Returns:
+//   - driver.Value: The underlying time.Time in UTC
+//   - error: Always nil
+func (t UnixTime) Value() (driver.Value, error) {
+	return time.Time(t).UTC(), nil
+}
+
+// Scan implements the sql.Scanner interface for UnixTime.
+//
+// Accepted source types:
+//   - int64: Epoch seconds or milliseconds, detected by magnitude
+//   - time.Time: Used as is
+//   - []byte, string: Epoch digits, or any format accepted by UTCTime.Scan
+//   - nil: Sets the zero time
+//
+// Parameters:
+//   - src: Value from the database driver
+//
+// Returns:
+//   - error: Error if src has an unsupported type or cannot be parsed
+//
+// Example:
+//
+//	var expiresAt types.UnixTime
+//	err := db.QueryRow("SELECT expires_at FROM sessions WHERE id = ?", id).Scan(&expiresAt)
+func (t *UnixTime) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*t = UnixTime(time.Time{})
+		return nil
+	case int64:
+		*t = unixTimeFromInt(v)
+		return nil
+	case time.Time:
+		*t = UnixTime(v.UTC())
+		return nil
+	case []byte:
+		return t.scanString(string(v))
+	case string:
+		return t.scanString(v)
+	default:
+		return fmt.Errorf("types: cannot scan %T into UnixTime", src)
+	}
+}
+
+// scanString parses s as epoch digits, falling back to the UTCTime text layouts
+func (t *UnixTime) scanString(s string) error {
+	if n, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64); err == nil {
+		*t = unixTimeFromInt(n)
+		return nil
+	}
+
+	var ut UTCTime
+	if err := ut.scanString(s); err != nil {
+		return fmt.Errorf("types: cannot parse %q as UnixTime", s)
+	}
+	*t = UnixTime(ut)
+	return nil
+}
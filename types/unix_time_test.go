@@ -0,0 +1,155 @@
+package types
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestUnixTimeMarshalJSON(t *testing.T) {
+	jakarta := time.FixedZone("WIB", 7*60*60)
+
+	tests := []struct {
+		name     string
+		input    UnixTime
+		expected string
+	}{
+		{"zero", UnixTime(time.Time{}), `0`},
+		{"utc", UnixTime(time.Date(2025, 10, 15, 4, 56, 56, 0, time.UTC)), `1760504216`},
+		{"offset", UnixTime(time.Date(2025, 10, 15, 11, 56, 56, 0, jakarta)), `1760504216`},
+		{"drops_sub_second", UnixTime(time.Date(2025, 10, 15, 4, 56, 56, 999_000_000, time.UTC)), `1760504216`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.input)
+			if err != nil {
+				t.Fatalf("Marshal failed: %v", err)
+			}
+			if string(data) != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, data)
+			}
+			if tt.input.String() != tt.expected {
+				t.Errorf("Expected String %s, got %s", tt.expected, tt.input.String())
+			}
+		})
+	}
+}
+
+func TestUnixTimeUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expected  time.Time
+		expectErr bool
+	}{
+		{"seconds", `1760504216`, time.Date(2025, 10, 15, 4, 56, 56, 0, time.UTC), false},
+		{"milliseconds", `1760504216123`, time.Date(2025, 10, 15, 4, 56, 56, 123_000_000, time.UTC), false},
+		// Just below the threshold is still seconds (far future), at the threshold it is milliseconds
+		{"largest_seconds", `999999999999`, time.Unix(999999999999, 0).UTC(), false},
+		{"smallest_milliseconds", `1000000000000`, time.Date(2001, 9, 9, 1, 46, 40, 0, time.UTC), false},
+		{"negative_seconds", `-86400`, time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC), false},
+		{"negative_milliseconds", `-1000000000000`, time.UnixMilli(-1000000000000).UTC(), false},
+		{"zero", `0`, time.Time{}, false},
+		{"null", `null`, time.Time{}, false},
+		{"empty_string", `""`, time.Time{}, false},
+		{"float", `1760504216.5`, time.Time{}, true},
+		{"rfc3339_string", `"2025-10-15T04:56:56Z"`, time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ut := UnixTime(time.Now())
+			err := json.Unmarshal([]byte(tt.input), &ut)
+			if tt.expectErr {
+				if err == nil {
+					t.Error("Expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unmarshal failed: %v", err)
+			}
+			if !time.Time(ut).Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, time.Time(ut))
+			}
+		})
+	}
+
+	t.Run("round_trip", func(t *testing.T) {
+		original := UnixTime(time.Date(2025, 10, 15, 4, 56, 56, 0, time.UTC))
+		data, _ := json.Marshal(original)
+		var decoded UnixTime
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if !time.Time(decoded).Equal(time.Time(original)) {
+			t.Errorf("Expected %v, got %v", time.Time(original), time.Time(decoded))
+		}
+	})
+}
+
+func TestUnixTimeSQL(t *testing.T) {
+	var ut UnixTime
+	var _ driver.Valuer = ut
+	var _ sql.Scanner = &ut
+
+	expected := time.Date(2025, 10, 15, 4, 56, 56, 0, time.UTC)
+
+	t.Run("value", func(t *testing.T) {
+		jakarta := time.FixedZone("WIB", 7*60*60)
+		v, err := UnixTime(time.Date(2025, 10, 15, 11, 56, 56, 0, jakarta)).Value()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, ok := v.(time.Time)
+		if !ok || got.Location() != time.UTC || !got.Equal(expected) {
+			t.Errorf("Expected UTC time, got %v", v)
+		}
+	})
+
+	tests := []struct {
+		name string
+		src  interface{}
+	}{
+		{"int64_seconds", int64(1760504216)},
+		{"int64_milliseconds", int64(1760504216000)},
+		{"time", expected},
+		{"bytes_digits", []byte("1760504216")},
+		{"string_datetime", "2025-10-15 04:56:56"},
+	}
+
+	for _, tt := range tests {
+		t.Run("scan_"+tt.name, func(t *testing.T) {
+			var got UnixTime
+			if err := got.Scan(tt.src); err != nil {
+				t.Fatalf("Scan failed: %v", err)
+			}
+			if !time.Time(got).Equal(expected) {
+				t.Errorf("Expected %v, got %v", expected, time.Time(got))
+			}
+		})
+	}
+
+	t.Run("scan_nil", func(t *testing.T) {
+		got := UnixTime(time.Now())
+		if err := got.Scan(nil); err != nil {
+			t.Fatal(err)
+		}
+		if !time.Time(got).IsZero() {
+			t.Errorf("Expected zero time, got %v", time.Time(got))
+		}
+	})
+
+	t.Run("scan_error", func(t *testing.T) {
+		var got UnixTime
+		if err := got.Scan(3.14); err == nil {
+			t.Error("Expected error for unsupported type")
+		}
+		if err := got.Scan("yesterday"); err == nil {
+			t.Error("Expected error for unparseable string")
+		}
+	})
+}
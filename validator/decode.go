@@ -0,0 +1,65 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// UnknownFieldError is returned by DecodeStrict when the JSON body contains a field
+// that is not defined on the target type.
+type UnknownFieldError struct {
+	Field string // JSON field name as sent by the client
+}
+
+// Error implements the error interface for UnknownFieldError.
+func (e *UnknownFieldError) Error() string {
+	return fmt.Sprintf("unknown field %q", e.Field)
+}
+
+// DecodeStrict decodes a JSON body into a new T, rejecting fields that are not defined on T.
+// This catches typos and deprecated parameters on strict endpoints. Trailing data after
+// the JSON value is rejected as well.
+//
+// Type Parameters:
+//   - T: The DTO type to decode into
+//
+// Parameters:
+//   - data: []byte - The raw JSON body
+//
+// Returns:
+//   - T: The decoded value
+//   - error: *UnknownFieldError for unknown fields, or the JSON syntax/type error
+//
+// Example:
+//
+//	req, err := validator.DecodeStrict[CreateUserRequest](c.Body())
+//	if err != nil {
+//	    return response.BadRequest(c, err.Error())
+//	}
+//	if err := validator.ValidateStructWithContext(c, &req); err != nil {
+//	    return response.ValidationErrorI18n(c, err)
+//	}
+func DecodeStrict[T any](data []byte) (T, error) {
+	var result T
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&result); err != nil {
+		// encoding/json reports unknown fields only as a formatted message
+		if field, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+			return result, &UnknownFieldError{Field: strings.Trim(field, `"`)}
+		}
+		return result, err
+	}
+
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return result, errors.New("unexpected data after JSON body")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,114 @@
+package validator
+
+import (
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// PasswordRequirements describes the rules enforced by the "password" validation tag.
+//
+// Fields:
+//   - MinLength: Minimum number of characters (runes); 0 disables the length check
+//   - RequireUpper: Require at least one uppercase letter
+//   - RequireLower: Require at least one lowercase letter
+//   - RequireDigit: Require at least one digit
+//   - RequireSpecial: Require at least one punctuation or symbol character
+type PasswordRequirements struct {
+	MinLength      int
+	RequireUpper   bool
+	RequireLower   bool
+	RequireDigit   bool
+	RequireSpecial bool
+}
+
+// PasswordPolicy is the policy applied by the "password" validation tag.
+// Change it at startup to tighten or relax password strength rules.
+//
+// Example:
+//
+//	validator.PasswordPolicy.MinLength = 12
+//	validator.PasswordPolicy.RequireSpecial = false
+//
+//	type SignupRequest struct {
+//	    Password string `json:"password" validate:"required,password"`
+//	}
+var PasswordPolicy = PasswordRequirements{
+	MinLength:      8,
+	RequireUpper:   true,
+	RequireLower:   true,
+	RequireDigit:   true,
+	RequireSpecial: true,
+}
+
+// Message tags reported for the "password" validation tag, one per requirement.
+// They are looked up in DefaultMessages and i18n ("validator.password_upper", ...).
+const (
+	passwordTagMin     = "password_min"
+	passwordTagUpper   = "password_upper"
+	passwordTagLower   = "password_lower"
+	passwordTagDigit   = "password_digit"
+	passwordTagSpecial = "password_special"
+)
+
+// validatePassword is the validator.Func registered for the "password" tag.
+func validatePassword(fl validator.FieldLevel) bool {
+	return passwordViolation(fl.Field().String(), PasswordPolicy) == ""
+}
+
+// passwordViolation returns the message tag of the first requirement in policy
+// that password fails, or an empty string if it satisfies all of them.
+func passwordViolation(password string, policy PasswordRequirements) string {
+	if utf8.RuneCountInString(password) < policy.MinLength {
+		return passwordTagMin
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSpecial bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			hasSpecial = true
+		}
+	}
+
+	switch {
+	case policy.RequireUpper && !hasUpper:
+		return passwordTagUpper
+	case policy.RequireLower && !hasLower:
+		return passwordTagLower
+	case policy.RequireDigit && !hasDigit:
+		return passwordTagDigit
+	case policy.RequireSpecial && !hasSpecial:
+		return passwordTagSpecial
+	}
+	return ""
+}
+
+// messageTagAndParam returns the tag and param used to build the message for a field error.
+// For the "password" tag it reports the specific requirement that failed, so the message
+// can say e.g. "password must contain at least one uppercase letter".
+func messageTagAndParam(e validator.FieldError) (string, string) {
+	if e.Tag() != "password" {
+		return e.Tag(), e.Param()
+	}
+
+	value, ok := e.Value().(string)
+	if !ok {
+		return e.Tag(), e.Param()
+	}
+
+	violation := passwordViolation(value, PasswordPolicy)
+	if violation == "" {
+		// Policy changed between validation and message generation
+		return e.Tag(), e.Param()
+	}
+	return violation, strconv.Itoa(PasswordPolicy.MinLength)
+}
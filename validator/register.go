@@ -0,0 +1,81 @@
+package validator
+
+import (
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// messagesMu guards DefaultMessages against RegisterDefaultMessage running concurrently with validation
+var messagesMu sync.RWMutex
+
+// RegisterValidation registers a custom validation rule on the global Validator,
+// so it can be used in `validate` struct tags and with ValidateMap.
+// Register rules during application startup, before validating; pair each rule with
+// RegisterDefaultMessage (and optionally a "validator.<tag>" i18n key) for a friendly message.
+//
+// Parameters:
+//   - tag: Tag name used in struct tags (e.g., "indonesian_phone")
+//   - fn: Validation function returning true when the field is valid
+//
+// Returns:
+//   - error: Error if the tag is empty or the function is nil
+//
+// Example:
+//
+//	// playground is github.com/go-playground/validator/v10
+//	validator.RegisterValidation("indonesian_phone", func(fl playground.FieldLevel) bool {
+//	    phone := fl.Field().String()
+//	    return strings.HasPrefix(phone, "+62") || strings.HasPrefix(phone, "08")
+//	})
+//	validator.RegisterDefaultMessage("indonesian_phone", "{{.FieldName}} must be an Indonesian phone number")
+//
+//	type Customer struct {
+//	    Phone string `json:"phone" validate:"required,indonesian_phone"`
+//	}
+func RegisterValidation(tag string, fn validator.Func) error {
+	return Validator.RegisterValidation(tag, fn)
+}
+
+// RegisterStructValidation registers a struct-level validation function on the global Validator
+// for cross-field rules that can't be expressed with per-field tags. Errors reported with
+// sl.ReportError are translated like field errors, using the reported tag and param.
+//
+// Parameters:
+//   - fn: Struct-level validation function
+//   - types: Struct values of the types the function applies to
+//
+// Example:
+//
+//	type DateRange struct {
+//	    StartDate time.Time `json:"start_date" validate:"required"`
+//	    EndDate   time.Time `json:"end_date" validate:"required"`
+//	}
+//
+//	validator.RegisterStructValidation(func(sl playground.StructLevel) {
+//	    r := sl.Current().Interface().(DateRange)
+//	    if !r.EndDate.After(r.StartDate) {
+//	        sl.ReportError(r.EndDate, "end_date", "EndDate", "gtfield", "start_date")
+//	    }
+//	}, DateRange{})
+//	// Message: end_date must be greater than start_date
+func RegisterStructValidation(fn validator.StructLevelFunc, types ...interface{}) {
+	Validator.RegisterStructValidation(fn, types...)
+}
+
+// RegisterDefaultMessage sets the fallback English message for a validation tag, used when
+// no i18n translation ("validator.<tag>") exists. It may also override a built-in message.
+// Templates use the placeholders {{.FieldName}}, {{.Param}} and {{.Tag}}.
+//
+// Parameters:
+//   - tag: Validation tag (e.g., "strong_password")
+//   - template: Message template
+//
+// Example:
+//
+//	validator.RegisterDefaultMessage("strong_password", "{{.FieldName}} is too weak")
+func RegisterDefaultMessage(tag, template string) {
+	messagesMu.Lock()
+	defer messagesMu.Unlock()
+	DefaultMessages[tag] = template
+}
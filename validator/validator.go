@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/budimanlai/go-pkg/i18n"
@@ -18,6 +19,11 @@ var (
 	// It is initialized automatically and can be used throughout the application.
 	Validator *validator.Validate
 
+	// FieldNameTag is the struct tag used to resolve field names in validation error messages.
+	// Change it to "form", "query", etc. when structs are bound from sources other than JSON.
+	// Default: "json"
+	FieldNameTag = "json"
+
 	// i18nManager holds the I18nManager instance for translation support.
 	// If set, validator will use i18n for error messages. If nil, it falls back to default English messages.
 	i18nManager *i18n.I18nManager
@@ -35,12 +41,53 @@ var (
 		"len":      "{{.FieldName}} must be exactly {{.Param}} characters",
 		"numeric":  "{{.FieldName}} must be numeric",
 		"alphanum": "{{.FieldName}} must contain only letters and numbers",
-		"default":  "{{.FieldName}} is invalid ({{.Tag}})",
+
+		"gt":               "{{.FieldName}} must be greater than {{.Param}}",
+		"lt":               "{{.FieldName}} must be less than {{.Param}}",
+		"eq":               "{{.FieldName}} must be equal to {{.Param}}",
+		"ne":               "{{.FieldName}} must not be equal to {{.Param}}",
+		"oneof":            "{{.FieldName}} must be one of: {{.Param}}",
+		"eqfield":          "{{.FieldName}} must match {{.Param}}",
+		"nefield":          "{{.FieldName}} must be different from {{.Param}}",
+		"gtfield":          "{{.FieldName}} must be greater than {{.Param}}",
+		"ltfield":          "{{.FieldName}} must be less than {{.Param}}",
+		"url":              "{{.FieldName}} must be a valid URL",
+		"uri":              "{{.FieldName}} must be a valid URI",
+		"uuid":             "{{.FieldName}} must be a valid UUID",
+		"uuid4":            "{{.FieldName}} must be a valid version 4 UUID",
+		"datetime":         "{{.FieldName}} must be a valid date/time in the format {{.Param}}",
+		"alpha":            "{{.FieldName}} must contain only letters",
+		"number":           "{{.FieldName}} must be a valid number",
+		"boolean":          "{{.FieldName}} must be a boolean value",
+		"lowercase":        "{{.FieldName}} must be lowercase",
+		"uppercase":        "{{.FieldName}} must be uppercase",
+		"contains":         "{{.FieldName}} must contain '{{.Param}}'",
+		"excludes":         "{{.FieldName}} must not contain '{{.Param}}'",
+		"startswith":       "{{.FieldName}} must start with '{{.Param}}'",
+		"endswith":         "{{.FieldName}} must end with '{{.Param}}'",
+		"unique":           "{{.FieldName}} must contain unique values",
+		"ip":               "{{.FieldName}} must be a valid IP address",
+		"ipv4":             "{{.FieldName}} must be a valid IPv4 address",
+		"ipv6":             "{{.FieldName}} must be a valid IPv6 address",
+		"e164":             "{{.FieldName}} must be a valid E.164 phone number",
+		"required_if":      "{{.FieldName}} is required",
+		"required_with":    "{{.FieldName}} is required when {{.Param}} is present",
+		"required_without": "{{.FieldName}} is required when {{.Param}} is not present",
+
+		"password":         "{{.FieldName}} does not meet the password requirements",
+		"password_min":     "{{.FieldName}} must be at least {{.Param}} characters long",
+		"password_upper":   "{{.FieldName}} must contain at least one uppercase letter",
+		"password_lower":   "{{.FieldName}} must contain at least one lowercase letter",
+		"password_digit":   "{{.FieldName}} must contain at least one digit",
+		"password_special": "{{.FieldName}} must contain at least one special character",
+
+		"default": "{{.FieldName}} is invalid ({{.Tag}})",
 	}
 )
 
 func init() {
 	Validator = validator.New()
+	_ = Validator.RegisterValidation("password", validatePassword)
 }
 
 // SetI18nManager sets the global I18nManager instance for validator translations.
@@ -65,6 +112,8 @@ func SetI18nManager(manager *i18n.I18nManager) {
 // Fields:
 //   - Messages: Slice of all validation error messages (for backward compatibility)
 //   - Errors: Map of field names to their error messages (for detailed error reporting)
+//   - ValueErrors: Map of field names to the rejected values, for debugging and logging only.
+//     It is never serialized; fields tagged `log:"redact"` hold RedactedValue instead.
 //
 // Methods:
 //   - Error(): Returns all messages joined by semicolon (implements error interface)
@@ -82,10 +131,14 @@ func SetI18nManager(manager *i18n.I18nManager) {
 //	    }
 //	}
 type ValidationError struct {
-	Messages []string            // All error messages (backward compatibility)
-	Errors   map[string][]string // Field name -> error messages mapping
+	Messages    []string               // All error messages (backward compatibility)
+	Errors      map[string][]string    // Field name -> error messages mapping
+	ValueErrors map[string]interface{} `json:"-"` // Field name -> rejected value (debugging only)
 }
 
+// RedactedValue replaces rejected values of fields tagged `log:"redact"` in ValidationError.ValueErrors.
+const RedactedValue = "[REDACTED]"
+
 // Error implements the error interface for ValidationError.
 // It returns all validation error messages joined by semicolons.
 //
@@ -179,55 +232,90 @@ func getLanguageFromContext(c *fiber.Ctx) string {
 	return "en" // fallback to English
 }
 
-// getFieldName retrieves the field name from json tag if available, otherwise returns the struct field name.
-// This ensures consistency between request/response JSON field names and validation error messages.
+// getFieldPath builds the error key for a field from the validator's struct namespace,
+// resolving the field name from the given struct tag at each level. Slice, array and map
+// indexes become path segments, so nested fields don't collide in the Errors map.
+// Embedded structs without a tag name are flattened, like encoding/json does.
 //
 // Parameters:
 //   - s: The struct being validated
-//   - fieldName: The struct field name from validator
+//   - namespace: The struct namespace from validator (e.g., "Order.Items[0].Price")
+//   - tagName: The struct tag to read field names from (e.g., "json", "form", "query")
 //
 // Returns:
-//   - string: JSON tag name if exists, otherwise original field name in title case
+//   - string: Dotted field path (e.g., "items.0.price")
+//   - string: Name of the innermost field, used in messages (e.g., "price")
 //
 // Example:
 //
-//	type User struct {
-//	    Email string `json:"email" validate:"required"`
+//	type Item struct {
+//	    Price int `json:"price" validate:"gt=0"`
+//	}
+//	type Order struct {
+//	    Items []Item `json:"items" validate:"dive"`
 //	}
-//	// getFieldName will return "email" instead of "Email"
-func getFieldName(s interface{}, fieldName string) string {
-	// Get the type of the struct
-	val := reflect.ValueOf(s)
-	if val.Kind() == reflect.Ptr {
-		val = val.Elem()
+//	// getFieldPath(order, "Order.Items[0].Price", "json") will return "items.0.price", "price"
+func getFieldPath(s interface{}, namespace string, tagName string) (string, string) {
+	typ := reflect.TypeOf(s)
+
+	// The first part is the name of the struct being validated
+	parts := strings.Split(namespace, ".")
+	if len(parts) > 1 {
+		parts = parts[1:]
 	}
 
-	// If not a struct, return title case of field name
-	if val.Kind() != reflect.Struct {
-		caser := cases.Title(language.Und)
-		return caser.String(fieldName)
-	}
+	var segments []string
+	var name string
+	for _, part := range parts {
+		fieldName, indexes := part, ""
+		if i := strings.Index(part, "["); i >= 0 {
+			fieldName, indexes = part[:i], part[i:]
+		}
+
+		for typ != nil && (typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array || typ.Kind() == reflect.Map) {
+			typ = typ.Elem()
+		}
 
-	// Try to find the field in the struct
-	typ := val.Type()
-	for i := 0; i < typ.NumField(); i++ {
-		field := typ.Field(i)
-		if field.Name == fieldName {
-			// Check if json tag exists
-			jsonTag := field.Tag.Get("json")
-			if jsonTag != "" {
-				// Handle json tag with options (e.g., "email,omitempty")
-				parts := strings.Split(jsonTag, ",")
-				if parts[0] != "" && parts[0] != "-" {
-					return parts[0]
+		name = titleCase(fieldName)
+		if typ != nil && typ.Kind() == reflect.Struct {
+			if field, ok := typ.FieldByName(fieldName); ok {
+				tagged := tagFieldName(field, tagName)
+				if tagged != "" {
+					name = tagged
+				} else if field.Anonymous && indexes == "" {
+					typ = field.Type
+					continue
 				}
+				typ = field.Type
+			} else {
+				typ = nil
+			}
+		}
+
+		segments = append(segments, name)
+		// "[0]" or "[key]", possibly repeated for nested collections
+		for _, index := range strings.Split(indexes, "[") {
+			if index = strings.TrimSuffix(index, "]"); index != "" {
+				segments = append(segments, index)
 			}
-			// If no json tag or json:"-", return title case of field name
-			break
 		}
 	}
 
-	// Fallback to title case of original field name
+	return strings.Join(segments, "."), name
+}
+
+// tagFieldName returns the name from the field's struct tag (e.g., "email" from `json:"email,omitempty"`),
+// or an empty string if the tag is missing or "-"
+func tagFieldName(field reflect.StructField, tagName string) string {
+	name := strings.Split(field.Tag.Get(tagName), ",")[0]
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
+// titleCase converts a struct field name to title case for messages
+func titleCase(fieldName string) string {
 	caser := cases.Title(language.Und)
 	return caser.String(fieldName)
 }
@@ -297,6 +385,30 @@ func ValidateStruct(s interface{}) error {
 //	    }
 //	}
 func ValidateStructWithLang(s interface{}, lang string) error {
+	return ValidateStructWithTag(s, lang, FieldNameTag)
+}
+
+// ValidateStructWithTag validates a struct like ValidateStructWithLang, but resolves field names
+// in error messages from the given struct tag instead of the package-level FieldNameTag.
+// If the tag is missing on a field, the title-cased struct field name is used.
+//
+// Parameters:
+//   - s: The struct to validate (must have validation tags)
+//   - lang: Language code for error messages (e.g., "en", "id", "zh")
+//   - tagName: Struct tag used for field names (e.g., "json", "form", "query")
+//
+// Returns:
+//   - error: nil if validation succeeds, *ValidationError if validation fails
+//
+// Example:
+//
+//	type LoginForm struct {
+//	    Username string `form:"username" validate:"required"`
+//	}
+//
+//	err := ValidateStructWithTag(LoginForm{}, "en", "form")
+//	// err.Error(): username is required
+func ValidateStructWithTag(s interface{}, lang string, tagName string) error {
 	err := Validator.Struct(s)
 	if err == nil {
 		return nil
@@ -304,17 +416,26 @@ func ValidateStructWithLang(s interface{}, lang string) error {
 
 	var messages []string
 	fieldErrors := make(map[string][]string)
+	valueErrors := make(map[string]interface{})
 
 	var validateErrs validator.ValidationErrors
 	if errors.As(err, &validateErrs) {
 		for _, e := range validateErrs {
-			// Get field name from json tag if available
-			fieldName := getFieldName(s, e.Field())
-			message := getUserFriendlyMessage(fieldName, e.Tag(), e.Param(), lang)
+			// Get the field path and name from the configured tag if available
+			fieldPath, fieldName := getFieldPath(s, e.StructNamespace(), tagName)
+			tag, param := messageTagAndParam(e)
+			message := getUserFriendlyMessage(fieldName, tag, param, lang)
 			messages = append(messages, message)
 
-			// Add to field errors map using json tag name
-			fieldErrors[fieldName] = append(fieldErrors[fieldName], message)
+			// Add to field errors map using the full path, so nested fields don't collide
+			fieldErrors[fieldPath] = append(fieldErrors[fieldPath], message)
+
+			// Keep the rejected value for debugging, unless the field is redacted
+			if isRedactedField(s, e.StructNamespace()) {
+				valueErrors[fieldPath] = RedactedValue
+			} else {
+				valueErrors[fieldPath] = e.Value()
+			}
 		}
 	} else {
 		// Jika bukan validation error, kembalikan error asli
@@ -322,8 +443,114 @@ func ValidateStructWithLang(s interface{}, lang string) error {
 	}
 
 	return &ValidationError{
-		Messages: messages,
-		Errors:   fieldErrors,
+		Messages:    messages,
+		Errors:      fieldErrors,
+		ValueErrors: valueErrors,
+	}
+}
+
+// isRedactedField reports whether the field at the validator's struct namespace
+// (e.g., "User.Credentials.Password" or "Order.Items[0].Secret"), or any struct field
+// containing it, has a `log:"redact"` tag.
+func isRedactedField(s interface{}, namespace string) bool {
+	typ := reflect.TypeOf(s)
+	parts := strings.Split(namespace, ".")
+	if typ == nil || len(parts) < 2 {
+		return false
+	}
+
+	for _, part := range parts[1:] {
+		// Strip slice/map indexes; the element type is resolved below
+		if i := strings.IndexByte(part, '['); i >= 0 {
+			part = part[:i]
+		}
+		for typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array || typ.Kind() == reflect.Map {
+			typ = typ.Elem()
+		}
+		if typ.Kind() != reflect.Struct {
+			return false
+		}
+
+		field, ok := typ.FieldByName(part)
+		if !ok {
+			return false
+		}
+		for _, opt := range strings.Split(field.Tag.Get("log"), ",") {
+			if strings.TrimSpace(opt) == "redact" {
+				return true
+			}
+		}
+		typ = field.Type
+	}
+	return false
+}
+
+// ValidateMap validates the values of a map against per-key validation rules.
+// It uses go-playground's ValidateMap and translates failures through the same message
+// pipeline as struct validation, using the map key as the field name.
+//
+// Keys that have no rule are not validated. Errors are reported in sorted key order.
+//
+// Parameters:
+//   - m: The map to validate
+//   - rules: Map of keys to validation tags (e.g., "required,max=10")
+//   - lang: Language code for error messages (e.g., "en", "id", "zh")
+//
+// Returns:
+//   - *ValidationError: nil if validation succeeds, otherwise the collected errors
+//
+// Example:
+//
+//	metadata := map[string]interface{}{"color": "red", "size": "extra-extra-large"}
+//	rules := map[string]string{"color": "required", "size": "required,max=5"}
+//	if verr := ValidateMap(metadata, rules, "en"); verr != nil {
+//	    fmt.Println(verr.First())
+//	    // Output: size must be at most 5 characters
+//	}
+func ValidateMap(m map[string]interface{}, rules map[string]string, lang string) *ValidationError {
+	mapRules := make(map[string]interface{}, len(rules))
+	for key, rule := range rules {
+		mapRules[key] = rule
+	}
+
+	errs := Validator.ValidateMap(m, mapRules)
+	if len(errs) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(errs))
+	for key := range errs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var messages []string
+	fieldErrors := make(map[string][]string)
+	valueErrors := make(map[string]interface{})
+
+	for _, key := range keys {
+		valueErrors[key] = m[key]
+
+		var validateErrs validator.ValidationErrors
+		if err, ok := errs[key].(error); ok && errors.As(err, &validateErrs) {
+			for _, e := range validateErrs {
+				tag, param := messageTagAndParam(e)
+				message := getUserFriendlyMessage(key, tag, param, lang)
+				messages = append(messages, message)
+				fieldErrors[key] = append(fieldErrors[key], message)
+			}
+			continue
+		}
+
+		message := fmt.Sprintf("%s: %v", key, errs[key])
+		messages = append(messages, message)
+		fieldErrors[key] = append(fieldErrors[key], message)
+	}
+
+	return &ValidationError{
+		Messages:    messages,
+		Errors:      fieldErrors,
+		ValueErrors: valueErrors,
 	}
 }
 
@@ -369,9 +596,10 @@ func ValidateStructWithContext(c *fiber.Ctx, s interface{}) error {
 // It uses i18n for translations if i18nManager is set, otherwise falls back to DefaultMessages.
 //
 // The function:
-//   - Uses field name from json tag for consistency with request/response
+//   - Uses field name from json tag for consistency with request/response,
+//     or its "validator.fields.<name>" translation when one exists
 //   - Uses i18n with "validator." prefix for message keys (e.g., "validator.required")
-//   - Falls back to DefaultMessages if i18n is not available or translation not found
+//   - Falls back to DefaultMessages[tag], then "validator.default" (i18n), then DefaultMessages["default"]
 //   - Supports template data with FieldName, Param, and Tag placeholders
 //
 // Parameters:
@@ -391,6 +619,13 @@ func ValidateStructWithContext(c *fiber.Ctx, s interface{}) error {
 //	msg := getUserFriendlyMessage("password", "min", "8", "id")
 //	// Returns: "password minimal 8 karakter"
 func getUserFriendlyMessage(fieldName, tag, param, lang string) string {
+	fieldName = getFieldLabel(fieldName, lang)
+
+	// oneof takes space-separated values; list them as "a, b, c" in the message
+	if tag == "oneof" {
+		param = strings.Join(strings.Fields(param), ", ")
+	}
+
 	// Prepare template data (fieldName already processed from json tag or title case)
 	templateData := map[string]string{
 		"FieldName": fieldName,
@@ -400,29 +635,29 @@ func getUserFriendlyMessage(fieldName, tag, param, lang string) string {
 
 	// Try to get message from i18n if available
 	if i18nManager != nil {
-		fmt.Println("Tag:", tag)
 		messageKey := "validator." + tag
-		message := i18nManager.Translate(lang, messageKey, templateData)
-
-		// Check if translation was found (i18n returns the key if not found)
-		if !strings.Contains(message, "Missing translation") {
+		if message, ok := lookupTranslation(lang, messageKey, templateData); ok {
 			return message
 		}
+	}
 
+	// A default message for the specific tag (e.g., registered with RegisterDefaultMessage)
+	// is more helpful than the generic one
+	messagesMu.RLock()
+	template, exists := DefaultMessages[tag]
+	defaultTemplate := DefaultMessages["default"]
+	messagesMu.RUnlock()
+
+	if !exists && i18nManager != nil {
 		// Try default key if specific tag not found
-		messageKey = "validator.default"
-		message = i18nManager.Translate(lang, messageKey, templateData)
-		if !strings.Contains(message, "Missing translation") {
+		if message, ok := lookupTranslation(lang, "validator.default", templateData); ok {
 			return message
 		}
 	}
 
-	fmt.Println("Falling back to default English messages")
-
 	// Fallback to default English messages
-	template, exists := DefaultMessages[tag]
 	if !exists {
-		template = DefaultMessages["default"]
+		template = defaultTemplate
 	}
 
 	// Simple template replacement for default messages
@@ -432,3 +667,36 @@ func getUserFriendlyMessage(fieldName, tag, param, lang string) string {
 
 	return message
 }
+
+// getFieldLabel returns the localized display name of a field from the "validator.fields.<name>"
+// i18n key, or the field name itself when i18nManager is not set or has no translation.
+//
+// Parameters:
+//   - fieldName: Name of the field (from json tag or struct field name)
+//   - lang: Language code for the display name
+//
+// Returns:
+//   - string: Localized field name, or fieldName
+//
+// Example:
+//
+//	// id.json: {"validator.fields.email": "Alamat email"}
+//	label := getFieldLabel("email", "id")
+//	// Returns: "Alamat email"
+func getFieldLabel(fieldName, lang string) string {
+	if i18nManager == nil {
+		return fieldName
+	}
+	if label, ok := lookupTranslation(lang, "validator.fields."+fieldName, nil); ok {
+		return label
+	}
+	return fieldName
+}
+
+// lookupTranslation probes i18nManager for an optional message. Misses are expected (most
+// fields have no label, most tags fall back to DefaultMessages) and are not reported to
+// the I18nConfig.OnMissing hook. i18nManager must not be nil.
+func lookupTranslation(lang, messageID string, templateData interface{}) (string, bool) {
+	message, err := i18nManager.TranslateOrError(lang, messageID, templateData)
+	return message, err == nil
+}
@@ -1,10 +1,17 @@
 package validator
 
 import (
+	"encoding/json"
+	"errors"
+	"io"
 	"net/http/httptest"
+	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/budimanlai/go-pkg/i18n"
+	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
 	"golang.org/x/text/language"
 )
@@ -29,6 +36,11 @@ type TestProduct struct {
 	Description string `json:"description" validate:"max=500"`
 }
 
+type TestLoginForm struct {
+	Username string `form:"username" json:"user_name" validate:"required"`
+	Password string `form:"password" validate:"required,min=8"`
+}
+
 type TestAddress struct {
 	Street  string `validate:"required"`
 	City    string `validate:"required,min=2"`
@@ -294,7 +306,7 @@ func TestValidateStruct_WithJSONTag(t *testing.T) {
 		err := ValidateStructWithLang(user, "en")
 		valErr := err.(*ValidationError)
 		fieldErrors := valErr.GetFieldErrors()
-		
+
 		if _, exists := fieldErrors["name"]; !exists {
 			t.Error("Expected 'name' from json tag")
 		}
@@ -312,13 +324,59 @@ func TestValidateStruct_WithJSONTag(t *testing.T) {
 		err := ValidateStructWithLang(product, "en")
 		valErr := err.(*ValidationError)
 		fieldErrors := valErr.GetFieldErrors()
-		
+
 		if _, exists := fieldErrors["product_name"]; !exists {
 			t.Error("Expected 'product_name' from json tag")
 		}
 	})
 }
 
+// Field Name Tag Tests
+func TestValidateStruct_WithFieldNameTag(t *testing.T) {
+	t.Run("form_tag_per_call", func(t *testing.T) {
+		setupI18n()
+		form := &TestLoginForm{Username: "", Password: "short"}
+		err := ValidateStructWithTag(form, "en", "form")
+		valErr := err.(*ValidationError)
+		fieldErrors := valErr.GetFieldErrors()
+
+		if _, exists := fieldErrors["username"]; !exists {
+			t.Error("Expected 'username' from form tag")
+		}
+		if _, exists := fieldErrors["password"]; !exists {
+			t.Error("Expected 'password' from form tag")
+		}
+		if valErr.First() != "username is required" {
+			t.Errorf("Expected 'username is required', got '%s'", valErr.First())
+		}
+	})
+
+	t.Run("form_tag_package_level", func(t *testing.T) {
+		setupI18n()
+		FieldNameTag = "form"
+		defer func() { FieldNameTag = "json" }()
+
+		form := &TestLoginForm{Username: "", Password: "password123"}
+		err := ValidateStructWithLang(form, "en")
+		valErr := err.(*ValidationError)
+
+		if _, exists := valErr.GetFieldErrors()["username"]; !exists {
+			t.Error("Expected 'username' from form tag")
+		}
+	})
+
+	t.Run("missing_tag_falls_back_to_field_name", func(t *testing.T) {
+		setupI18n()
+		form := &TestLoginForm{Username: "john", Password: ""}
+		err := ValidateStructWithTag(form, "en", "query")
+		valErr := err.(*ValidationError)
+
+		if _, exists := valErr.GetFieldErrors()["Password"]; !exists {
+			t.Error("Expected 'Password' fallback when tag is missing")
+		}
+	})
+}
+
 // Edge Cases Tests
 func TestValidation_EdgeCases(t *testing.T) {
 	t.Run("nil_struct", func(t *testing.T) {
@@ -428,3 +486,591 @@ func TestSetI18nManager(t *testing.T) {
 		setupI18n()
 	})
 }
+
+// ValidateMap Tests
+func TestValidateMap(t *testing.T) {
+	t.Run("value_violates_max_rule", func(t *testing.T) {
+		setupI18n()
+		metadata := map[string]interface{}{
+			"color": "red",
+			"size":  "extra-extra-large",
+		}
+		rules := map[string]string{
+			"color": "required",
+			"size":  "required,max=5",
+		}
+
+		verr := ValidateMap(metadata, rules, "en")
+		if verr == nil {
+			t.Fatal("Expected validation error, got nil")
+		}
+		if verr.First() != "size must be at most 5 characters" {
+			t.Errorf("Expected 'size must be at most 5 characters', got '%s'", verr.First())
+		}
+		if _, exists := verr.GetFieldErrors()["size"]; !exists {
+			t.Error("Expected 'size' key in field errors")
+		}
+		if _, exists := verr.GetFieldErrors()["color"]; exists {
+			t.Error("Expected no error for 'color'")
+		}
+	})
+
+	t.Run("valid_map", func(t *testing.T) {
+		setupI18n()
+		metadata := map[string]interface{}{"color": "red"}
+		rules := map[string]string{"color": "required,max=5"}
+
+		if verr := ValidateMap(metadata, rules, "en"); verr != nil {
+			t.Errorf("Expected no error, got %v", verr)
+		}
+	})
+
+	t.Run("missing_required_key", func(t *testing.T) {
+		setupI18n()
+		verr := ValidateMap(map[string]interface{}{}, map[string]string{"color": "required"}, "id")
+		if verr == nil {
+			t.Fatal("Expected validation error, got nil")
+		}
+		if verr.First() != "color wajib diisi" {
+			t.Errorf("Expected 'color wajib diisi', got '%s'", verr.First())
+		}
+	})
+}
+
+func TestDecodeStrict(t *testing.T) {
+	type CreateUserRequest struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	}
+
+	t.Run("clean_body", func(t *testing.T) {
+		req, err := DecodeStrict[CreateUserRequest]([]byte(`{"name":"John","email":"john@example.com"}`))
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if req.Name != "John" || req.Email != "john@example.com" {
+			t.Errorf("Unexpected decoded value: %+v", req)
+		}
+	})
+
+	t.Run("unknown_field", func(t *testing.T) {
+		_, err := DecodeStrict[CreateUserRequest]([]byte(`{"name":"John","emial":"john@example.com"}`))
+
+		var fieldErr *UnknownFieldError
+		if !errors.As(err, &fieldErr) {
+			t.Fatalf("Expected UnknownFieldError, got %v", err)
+		}
+		if fieldErr.Field != "emial" {
+			t.Errorf("Expected field 'emial', got '%s'", fieldErr.Field)
+		}
+		if err.Error() != `unknown field "emial"` {
+			t.Errorf("Unexpected error message: %s", err.Error())
+		}
+	})
+
+	t.Run("invalid_json", func(t *testing.T) {
+		if _, err := DecodeStrict[CreateUserRequest]([]byte(`{"name":`)); err == nil {
+			t.Error("Expected error for malformed JSON")
+		}
+	})
+
+	t.Run("trailing_data", func(t *testing.T) {
+		if _, err := DecodeStrict[CreateUserRequest]([]byte(`{"name":"John"}{"name":"Jane"}`)); err == nil {
+			t.Error("Expected error for trailing data")
+		}
+	})
+}
+
+// Password Tests
+func TestPasswordValidation(t *testing.T) {
+	type SignupRequest struct {
+		Password string `json:"password" validate:"required,password"`
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		expected string
+	}{
+		{"too_short", "Ab1!", "password must be at least 8 characters long"},
+		{"missing_upper", "abcdef1!", "password must contain at least one uppercase letter"},
+		{"missing_lower", "ABCDEF1!", "password must contain at least one lowercase letter"},
+		{"missing_digit", "Abcdefg!", "password must contain at least one digit"},
+		{"missing_special", "Abcdefg1", "password must contain at least one special character"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateStructWithLang(SignupRequest{Password: tt.password}, "en")
+			if err == nil {
+				t.Fatalf("Expected validation error for %q", tt.password)
+			}
+			if msg := err.(*ValidationError).First(); msg != tt.expected {
+				t.Errorf("Expected '%s', got '%s'", tt.expected, msg)
+			}
+		})
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		if err := ValidateStructWithLang(SignupRequest{Password: "Abcdef1!"}, "en"); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+	})
+
+	t.Run("indonesian", func(t *testing.T) {
+		setupI18n()
+		err := ValidateStructWithLang(SignupRequest{Password: "abcdef1!"}, "id")
+		expected := "password harus mengandung minimal satu huruf besar"
+		if err == nil || err.(*ValidationError).First() != expected {
+			t.Errorf("Expected '%s', got %v", expected, err)
+		}
+	})
+
+	t.Run("without_i18n", func(t *testing.T) {
+		SetI18nManager(nil)
+		defer setupI18n()
+
+		err := ValidateStructWithLang(SignupRequest{Password: "Abcdefg1"}, "en")
+		expected := "password must contain at least one special character"
+		if err == nil || err.(*ValidationError).First() != expected {
+			t.Errorf("Expected '%s', got %v", expected, err)
+		}
+	})
+
+	t.Run("custom_policy", func(t *testing.T) {
+		original := PasswordPolicy
+		defer func() { PasswordPolicy = original }()
+
+		PasswordPolicy = PasswordRequirements{MinLength: 12}
+		if err := ValidateStructWithLang(SignupRequest{Password: "alllowercase"}, "en"); err != nil {
+			t.Errorf("Expected no error with relaxed policy, got %v", err)
+		}
+
+		err := ValidateStructWithLang(SignupRequest{Password: "Abcdef1!"}, "en")
+		expected := "password must be at least 12 characters long"
+		if err == nil || err.(*ValidationError).First() != expected {
+			t.Errorf("Expected '%s', got %v", expected, err)
+		}
+	})
+}
+
+// ValueErrors Tests
+func TestRegisterValidation(t *testing.T) {
+	err := RegisterValidation("indonesian_phone", func(fl validator.FieldLevel) bool {
+		phone := fl.Field().String()
+		return strings.HasPrefix(phone, "+62") || strings.HasPrefix(phone, "08")
+	})
+	if err != nil {
+		t.Fatalf("RegisterValidation failed: %v", err)
+	}
+	RegisterDefaultMessage("indonesian_phone", "{{.FieldName}} must be an Indonesian phone number")
+
+	type Customer struct {
+		Phone string `json:"phone" validate:"required,indonesian_phone"`
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		if err := ValidateStructWithLang(Customer{Phone: "081234567890"}, "en"); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+	})
+
+	t.Run("default_message", func(t *testing.T) {
+		SetI18nManager(nil)
+		err := ValidateStructWithLang(Customer{Phone: "+1555"}, "en")
+		expected := "phone must be an Indonesian phone number"
+		if err == nil || err.(*ValidationError).First() != expected {
+			t.Errorf("Expected '%s', got %v", expected, err)
+		}
+	})
+
+	t.Run("default_message_preferred_over_generic_i18n", func(t *testing.T) {
+		setupI18n()
+		defer SetI18nManager(nil)
+		err := ValidateStructWithLang(Customer{Phone: "+1555"}, "id")
+		expected := "phone must be an Indonesian phone number"
+		if err == nil || err.(*ValidationError).First() != expected {
+			t.Errorf("Expected '%s', got %v", expected, err)
+		}
+	})
+
+	t.Run("validate_map", func(t *testing.T) {
+		err := ValidateMap(map[string]interface{}{"phone": "+1555"}, map[string]string{"phone": "indonesian_phone"}, "en")
+		if err == nil {
+			t.Error("Expected custom rule to apply to ValidateMap")
+		}
+	})
+
+	t.Run("invalid_registration", func(t *testing.T) {
+		if err := RegisterValidation("", func(validator.FieldLevel) bool { return true }); err == nil {
+			t.Error("Expected error for empty tag")
+		}
+	})
+}
+
+func TestValidation_NoStdoutOutput(t *testing.T) {
+	old := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Stdout = w
+
+	// Exercise both the i18n and the DefaultMessages paths
+	setupI18n()
+	ValidateStructWithLang(TestUser{Email: "invalid"}, "id")
+	SetI18nManager(nil)
+	ValidateStructWithLang(TestUser{Email: "invalid"}, "en")
+	ValidateMap(map[string]interface{}{"size": "too-long"}, map[string]string{"size": "max=3"}, "en")
+
+	w.Close()
+	os.Stdout = old
+
+	output, _ := io.ReadAll(r)
+	if len(output) != 0 {
+		t.Errorf("Expected no stdout output during validation, got: %q", output)
+	}
+}
+
+func TestDefaultMessages_MoreTags(t *testing.T) {
+	type Booking struct {
+		Status          string `json:"status" validate:"oneof=pending approved rejected"`
+		Website         string `json:"website" validate:"url"`
+		ID              string `json:"id" validate:"uuid"`
+		Guests          int    `json:"guests" validate:"gt=0"`
+		Nights          int    `json:"nights" validate:"lt=30"`
+		Password        string `json:"password"`
+		PasswordConfirm string `json:"password_confirm" validate:"eqfield=Password"`
+		Date            string `json:"date" validate:"datetime=2006-01-02"`
+	}
+	booking := Booking{
+		Status:          "cancelled",
+		Website:         "not a url",
+		ID:              "123",
+		Guests:          0,
+		Nights:          30,
+		Password:        "secret",
+		PasswordConfirm: "other",
+		Date:            "16/10/2026",
+	}
+
+	tests := []struct {
+		name     string
+		lang     string
+		withI18n bool
+		expected map[string]string
+	}{
+		{
+			name: "default_messages",
+			lang: "en",
+			expected: map[string]string{
+				"status":           "status must be one of: pending, approved, rejected",
+				"website":          "website must be a valid URL",
+				"id":               "id must be a valid UUID",
+				"guests":           "guests must be greater than 0",
+				"nights":           "nights must be less than 30",
+				"password_confirm": "password_confirm must match Password",
+				"date":             "date must be a valid date/time in the format 2006-01-02",
+			},
+		},
+		{
+			name:     "i18n_id",
+			lang:     "id",
+			withI18n: true,
+			expected: map[string]string{
+				"status":           "status harus salah satu dari: pending, approved, rejected",
+				"website":          "website harus berupa URL yang valid",
+				"id":               "id harus berupa UUID yang valid",
+				"guests":           "guests harus lebih besar dari 0",
+				"nights":           "nights harus lebih kecil dari 30",
+				"password_confirm": "password_confirm harus sama dengan Password",
+				"date":             "date harus berupa tanggal/waktu yang valid dengan format 2006-01-02",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.withI18n {
+				setupI18n()
+			}
+			defer SetI18nManager(nil)
+
+			err := ValidateStructWithLang(booking, tt.lang)
+			if err == nil {
+				t.Fatal("Expected validation errors")
+			}
+			fieldErrors := err.(*ValidationError).GetFieldErrors()
+			for field, expected := range tt.expected {
+				if !contains(fieldErrors[field], expected) {
+					t.Errorf("Field %s: expected '%s', got %v", field, expected, fieldErrors[field])
+				}
+			}
+		})
+	}
+}
+
+func TestValidateStruct_NestedFieldPaths(t *testing.T) {
+	type Item struct {
+		Name  string `json:"name" validate:"required"`
+		Price int    `json:"price" validate:"gt=0"`
+	}
+	type Audit struct {
+		Creator string `validate:"required"`
+	}
+	type Order struct {
+		Audit
+		Customer string          `json:"customer" validate:"required"`
+		Items    []Item          `json:"items" validate:"required,dive"`
+		Extras   map[string]Item `json:"extras" validate:"dive"`
+		Shipping *Item           `validate:"required"`
+	}
+
+	order := Order{
+		Items:    []Item{{Name: "Book", Price: 0}, {Name: "", Price: 5}, {Name: "Pen", Price: -1}},
+		Extras:   map[string]Item{"gift": {Name: "Wrap", Price: 0}},
+		Shipping: &Item{Name: "Express", Price: 0},
+	}
+
+	err := ValidateStructWithLang(order, "en")
+	verr, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("Expected *ValidationError, got %T", err)
+	}
+
+	expected := map[string]string{
+		"customer":          "customer is required",
+		"items.0.price":     "price must be greater than 0",
+		"items.1.name":      "name is required",
+		"items.2.price":     "price must be greater than 0",
+		"extras.gift.price": "price must be greater than 0",
+		"Shipping.price":    "price must be greater than 0",
+		"Creator":           "Creator is required",
+	}
+	fieldErrors := verr.GetFieldErrors()
+	for path, message := range expected {
+		if !contains(fieldErrors[path], message) {
+			t.Errorf("Path %s: expected '%s', got %v", path, message, fieldErrors[path])
+		}
+	}
+	if len(fieldErrors) != len(expected) {
+		t.Errorf("Expected %d field paths, got %v", len(expected), fieldErrors)
+	}
+	if verr.ValueErrors["items.2.price"] != -1 {
+		t.Errorf("Expected rejected value under path, got %v", verr.ValueErrors["items.2.price"])
+	}
+}
+
+func TestValidationError_ValueErrors(t *testing.T) {
+	type Credentials struct {
+		Secret string `json:"secret" validate:"min=10"`
+	}
+	type SignupRequest struct {
+		Email       string       `json:"email" validate:"required,email"`
+		Age         int          `json:"age" validate:"gte=18"`
+		Password    string       `json:"password" validate:"min=8" log:"redact"`
+		Credentials *Credentials `json:"credentials" log:"redact"`
+	}
+
+	req := SignupRequest{
+		Email:       "not-an-email",
+		Age:         15,
+		Password:    "hunter2",
+		Credentials: &Credentials{Secret: "short"},
+	}
+
+	err := ValidateStructWithLang(req, "en")
+	verr, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("Expected *ValidationError, got %T", err)
+	}
+
+	if verr.ValueErrors["email"] != "not-an-email" {
+		t.Errorf("Expected rejected email to be captured, got %v", verr.ValueErrors["email"])
+	}
+	if verr.ValueErrors["age"] != 15 {
+		t.Errorf("Expected rejected age to be captured, got %v", verr.ValueErrors["age"])
+	}
+	if verr.ValueErrors["password"] != RedactedValue {
+		t.Errorf("Expected password to be redacted, got %v", verr.ValueErrors["password"])
+	}
+	// Nested fields are keyed by their full path
+	if verr.ValueErrors["credentials.secret"] != RedactedValue {
+		t.Errorf("Expected nested field under redacted struct to be redacted, got %v", verr.ValueErrors["credentials.secret"])
+	}
+
+	// Rejected values must never reach the client
+	data, _ := json.Marshal(verr)
+	if strings.Contains(string(data), "not-an-email") || strings.Contains(string(data), "ValueErrors") {
+		t.Errorf("Expected ValueErrors to be excluded from JSON, got %s", data)
+	}
+}
+
+func TestValidateMap_ValueErrors(t *testing.T) {
+	verr := ValidateMap(map[string]interface{}{"size": "extra-large"}, map[string]string{"size": "max=5"}, "en")
+	if verr == nil {
+		t.Fatal("Expected validation error")
+	}
+	if verr.ValueErrors["size"] != "extra-large" {
+		t.Errorf("Expected rejected value to be captured, got %v", verr.ValueErrors["size"])
+	}
+}
+
+func TestValidateVar(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		if err := ValidateVar("user@example.com", "required,email", "en"); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+	})
+
+	t.Run("invalid_default_message", func(t *testing.T) {
+		SetI18nManager(nil)
+		err := ValidateVar("not-an-email", "required,email", "en")
+		valErr, ok := err.(*ValidationError)
+		if !ok {
+			t.Fatalf("Expected *ValidationError, got %T", err)
+		}
+		if valErr.First() != "value must be a valid email address" {
+			t.Errorf("Unexpected message: '%s'", valErr.First())
+		}
+		if len(valErr.GetFieldErrors()["value"]) != 1 {
+			t.Errorf("Expected field error under 'value', got %v", valErr.GetFieldErrors())
+		}
+		if valErr.ValueErrors["value"] != "not-an-email" {
+			t.Errorf("Expected rejected value, got %v", valErr.ValueErrors["value"])
+		}
+	})
+
+	t.Run("invalid_i18n", func(t *testing.T) {
+		setupI18n()
+		defer SetI18nManager(nil)
+		err := ValidateVar("123", "uuid", "id")
+		if err == nil || err.(*ValidationError).First() != "value harus berupa UUID yang valid" {
+			t.Errorf("Expected Indonesian UUID message, got %v", err)
+		}
+	})
+
+	t.Run("with_context", func(t *testing.T) {
+		setupI18n()
+		defer SetI18nManager(nil)
+		app := fiber.New()
+		app.Get("/test", func(c *fiber.Ctx) error {
+			c.Locals("language", "id")
+			err := ValidateVarWithContext(c, "", "required")
+			if err == nil || err.(*ValidationError).First() != "value wajib diisi" {
+				t.Errorf("Expected Indonesian required message, got %v", err)
+			}
+			return c.SendString("OK")
+		})
+		req := httptest.NewRequest("GET", "/test", nil)
+		app.Test(req)
+	})
+}
+
+func TestRegisterStructValidation(t *testing.T) {
+	type DateRange struct {
+		StartDate time.Time `json:"start_date" validate:"required"`
+		EndDate   time.Time `json:"end_date" validate:"required"`
+	}
+	type Booking struct {
+		Period DateRange `json:"period"`
+	}
+
+	RegisterStructValidation(func(sl validator.StructLevel) {
+		r := sl.Current().Interface().(DateRange)
+		if !r.EndDate.After(r.StartDate) {
+			sl.ReportError(r.EndDate, "end_date", "EndDate", "gtfield", "start_date")
+		}
+	}, DateRange{})
+
+	start := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+
+	t.Run("valid", func(t *testing.T) {
+		if err := ValidateStructWithLang(DateRange{StartDate: start, EndDate: start.AddDate(0, 0, 3)}, "en"); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+	})
+
+	t.Run("invalid_default_message", func(t *testing.T) {
+		SetI18nManager(nil)
+		err := ValidateStructWithLang(DateRange{StartDate: start, EndDate: start}, "en")
+		verr, ok := err.(*ValidationError)
+		if !ok {
+			t.Fatalf("Expected *ValidationError, got %T", err)
+		}
+		expected := "end_date must be greater than start_date"
+		if !contains(verr.GetFieldErrors()["end_date"], expected) {
+			t.Errorf("Expected '%s', got %v", expected, verr.GetFieldErrors())
+		}
+	})
+
+	t.Run("invalid_i18n", func(t *testing.T) {
+		setupI18n()
+		defer SetI18nManager(nil)
+		err := ValidateStructWithLang(DateRange{StartDate: start, EndDate: start.AddDate(0, 0, -1)}, "id")
+		if err == nil || err.(*ValidationError).First() != "end_date harus lebih besar dari start_date" {
+			t.Errorf("Expected Indonesian message, got %v", err)
+		}
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		err := ValidateStructWithLang(Booking{Period: DateRange{StartDate: start, EndDate: start}}, "en")
+		if err == nil || len(err.(*ValidationError).GetFieldErrors()["period.end_date"]) != 1 {
+			t.Errorf("Expected error under 'period.end_date', got %v", err)
+		}
+	})
+}
+
+func TestFieldLabelTranslation(t *testing.T) {
+	manager := setupI18n()
+	defer SetI18nManager(nil)
+	if err := manager.AddMessages("id", map[string]string{"validator.fields.email": "Alamat email"}); err != nil {
+		t.Fatal(err)
+	}
+
+	user := TestUserWithJSON{Name: "John", Email: "", Age: 20}
+
+	t.Run("translated_label", func(t *testing.T) {
+		err := ValidateStructWithLang(user, "id")
+		verr := err.(*ValidationError)
+		if verr.First() != "Alamat email wajib diisi" {
+			t.Errorf("Expected localized field name, got '%s'", verr.First())
+		}
+		// Error keys stay the raw json names
+		if _, ok := verr.GetFieldErrors()["email"]; !ok {
+			t.Errorf("Expected errors keyed by 'email', got %v", verr.GetFieldErrors())
+		}
+	})
+
+	t.Run("fallback_to_json_tag", func(t *testing.T) {
+		err := ValidateStructWithLang(TestUserWithJSON{Email: "john@example.com", Age: 20}, "id")
+		if err == nil || err.(*ValidationError).First() != "name wajib diisi" {
+			t.Errorf("Expected json tag name, got %v", err)
+		}
+	})
+}
+
+func TestFieldLabelDoesNotReportMissing(t *testing.T) {
+	var misses []string
+	manager, err := i18n.NewI18nManager(i18n.I18nConfig{
+		DefaultLanguage: language.English,
+		SupportedLangs:  []string{"en", "id"},
+		LocalesPath:     "../locales",
+		OnMissing: func(lang, messageID string) {
+			misses = append(misses, messageID)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetI18nManager(manager)
+	defer SetI18nManager(nil)
+
+	// No "validator.fields.*" labels exist, so every label lookup misses
+	if err := ValidateStructWithLang(TestUserWithJSON{Age: 20}, "id"); err == nil {
+		t.Fatal("Expected validation error")
+	}
+	if len(misses) != 0 {
+		t.Errorf("Expected label lookups not to be reported as missing, got %v", misses)
+	}
+}
@@ -0,0 +1,83 @@
+package validator
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+// varFieldName is the field name used in messages and error maps for ValidateVar
+const varFieldName = "value"
+
+// ValidateVar validates a single value against validation tags, without wrapping it in a struct.
+// Failures are returned as *ValidationError with messages built from the tag, using "value"
+// as the field name (e.g., "value must be a valid email address").
+//
+// Parameters:
+//   - value: The value to validate
+//   - tag: Validation tags (e.g., "required,email")
+//   - lang: Language code for error messages (e.g., "en", "id", "zh")
+//
+// Returns:
+//   - error: nil if validation succeeds, *ValidationError if validation fails
+//
+// Example:
+//
+//	if err := ValidateVar(c.Query("email"), "required,email", "en"); err != nil {
+//	    fmt.Println(err.(*ValidationError).First())
+//	    // Output: value must be a valid email address
+//	}
+func ValidateVar(value interface{}, tag string, lang string) error {
+	err := Validator.Var(value, tag)
+	if err == nil {
+		return nil
+	}
+
+	var messages []string
+	fieldErrors := make(map[string][]string)
+	valueErrors := make(map[string]interface{})
+
+	var validateErrs validator.ValidationErrors
+	if errors.As(err, &validateErrs) {
+		for _, e := range validateErrs {
+			tag, param := messageTagAndParam(e)
+			message := getUserFriendlyMessage(varFieldName, tag, param, lang)
+			messages = append(messages, message)
+			fieldErrors[varFieldName] = append(fieldErrors[varFieldName], message)
+		}
+		valueErrors[varFieldName] = value
+	} else {
+		messages = append(messages, err.Error())
+	}
+
+	return &ValidationError{
+		Messages:    messages,
+		Errors:      fieldErrors,
+		ValueErrors: valueErrors,
+	}
+}
+
+// ValidateVarWithContext validates a single value like ValidateVar, using the language
+// from the Fiber context (set by I18nMiddleware) for error messages.
+//
+// Parameters:
+//   - c: *fiber.Ctx - The Fiber context containing language information
+//   - value: The value to validate
+//   - tag: Validation tags (e.g., "required,uuid")
+//
+// Returns:
+//   - error: nil if validation succeeds, *ValidationError if validation fails
+//
+// Example:
+//
+//	app.Get("/orders", func(c *fiber.Ctx) error {
+//	    if err := ValidateVarWithContext(c, c.Query("customer_id"), "required,uuid"); err != nil {
+//	        return response.ValidationErrorI18n(c, err)
+//	    }
+//	    // ...
+//	})
+func ValidateVarWithContext(c *fiber.Ctx, value interface{}, tag string) error {
+	lang := getLanguageFromContext(c)
+	return ValidateVar(value, tag, lang)
+}